@@ -0,0 +1,193 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noamduncore
+
+package collector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// amdUMCCASCmd is the umc_cas_cmd event of the amd_umc PMU (event:0-7),
+	// rdwrmask (bits 8-9) selects reads (1) or writes (2).
+	amdUMCCASCmd = 0x0a
+	amdUMCRead   = 1 << 8
+	amdUMCWrite  = 2 << 8
+	// amdUMCBytesPerCAS is the amount of data transferred per CAS command,
+	// one 64 byte cache line.
+	amdUMCBytesPerCAS = 64
+)
+
+// amdUMCCounter is an open perf event counting read or write CAS commands of
+// a memory controller.
+type amdUMCCounter struct {
+	fd    int
+	node  string
+	write bool
+}
+
+type amdUncoreCollector struct {
+	counters []amdUMCCounter
+	read     *prometheus.Desc
+	write    *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("amduncore", defaultDisabled, NewAMDUncoreCollector)
+}
+
+// NewAMDUncoreCollector returns a new Collector exposing the memory bandwidth
+// of AMD Unified Memory Controllers, it opens a read and a write counter per
+// memory controller.
+func NewAMDUncoreCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "amd_umc"
+
+	c := &amdUncoreCollector{
+		read: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "read_bandwidth_bytes_total"),
+			"Bytes read from memory by the memory controllers of the NUMA node.",
+			[]string{"node"}, nil,
+		),
+		write: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "write_bandwidth_bytes_total"),
+			"Bytes written to memory by the memory controllers of the NUMA node.",
+			[]string{"node"}, nil,
+		),
+		logger: logger,
+	}
+
+	// The amd_umc PMU is available since Linux 6.9 on Zen 4 and newer.
+	pmus, err := filepath.Glob(sysFilePath("bus/event_source/devices/amd_umc_*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, pmu := range pmus {
+		pmuType, err := readUintFromFile(filepath.Join(pmu, "type"))
+		if err != nil {
+			c.close()
+			return nil, err
+		}
+		// Uncore events have to be opened on the CPU listed in cpumask.
+		cpumask, err := os.ReadFile(filepath.Join(pmu, "cpumask"))
+		if err != nil {
+			c.close()
+			return nil, err
+		}
+		cpu, err := strconv.Atoi(strings.Split(strings.TrimSpace(string(cpumask)), ",")[0])
+		if err != nil {
+			c.close()
+			return nil, fmt.Errorf("invalid cpumask of %s: %w", filepath.Base(pmu), err)
+		}
+		node, err := cpuNUMANode(cpu)
+		if err != nil {
+			c.close()
+			return nil, err
+		}
+
+		for _, write := range []bool{false, true} {
+			attr := &unix.PerfEventAttr{
+				Type:   uint32(pmuType),
+				Size:   uint32(unsafe.Sizeof(unix.PerfEventAttr{})),
+				Config: amdUMCConfig(write),
+			}
+			fd, err := unix.PerfEventOpen(attr, -1, cpu, -1, unix.PERF_FLAG_FD_CLOEXEC)
+			if err != nil {
+				c.close()
+				return nil, fmt.Errorf("failed to open %s event: %w", filepath.Base(pmu), err)
+			}
+			c.counters = append(c.counters, amdUMCCounter{fd: fd, node: node, write: write})
+		}
+	}
+
+	return c, nil
+}
+
+// close releases the perf events of all counters.
+func (c *amdUncoreCollector) close() {
+	for _, counter := range c.counters {
+		if err := unix.Close(counter.fd); err != nil {
+			c.logger.Debug("failed to close memory controller counter", "node", counter.node, "err", err)
+		}
+	}
+}
+
+func (c *amdUncoreCollector) Update(ch chan<- prometheus.Metric) error {
+	if len(c.counters) == 0 {
+		c.logger.Debug("no AMD memory controller PMUs found")
+		return ErrNoData
+	}
+
+	read, written := map[string]uint64{}, map[string]uint64{}
+	buf := make([]byte, 8)
+	for _, counter := range c.counters {
+		if _, err := unix.Read(counter.fd, buf); err != nil {
+			return fmt.Errorf("failed to read memory controller counter: %w", err)
+		}
+		bytes := amdUMCBytes(binary.NativeEndian.Uint64(buf))
+		if counter.write {
+			written[counter.node] += bytes
+		} else {
+			read[counter.node] += bytes
+		}
+	}
+
+	for node, bytes := range read {
+		ch <- prometheus.MustNewConstMetric(c.read, prometheus.CounterValue, float64(bytes), node)
+	}
+	for node, bytes := range written {
+		ch <- prometheus.MustNewConstMetric(c.write, prometheus.CounterValue, float64(bytes), node)
+	}
+
+	return nil
+}
+
+// amdUMCConfig returns the perf event config counting the read or write CAS
+// commands of a memory controller, umc_cas_cmd.rd or umc_cas_cmd.wr.
+func amdUMCConfig(write bool) uint64 {
+	if write {
+		return amdUMCCASCmd | amdUMCWrite
+	}
+	return amdUMCCASCmd | amdUMCRead
+}
+
+// amdUMCBytes converts a number of CAS commands to the bytes transferred.
+func amdUMCBytes(casCmds uint64) uint64 {
+	return casCmds * amdUMCBytesPerCAS
+}
+
+// cpuNUMANode returns the NUMA node of a CPU, from the node<N> link in its
+// sysfs directory.
+func cpuNUMANode(cpu int) (string, error) {
+	nodes, err := filepath.Glob(sysFilePath(fmt.Sprintf("devices/system/cpu/cpu%d/node[0-9]*", cpu)))
+	if err != nil {
+		return "", err
+	}
+	if len(nodes) == 0 {
+		// Kernels without NUMA support have no node links.
+		return "0", nil
+	}
+	return strings.TrimPrefix(filepath.Base(nodes[0]), "node"), nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noamduncore
+
+package collector
+
+import "testing"
+
+func TestAMDUMCConfig(t *testing.T) {
+	// umc_cas_cmd.rd and umc_cas_cmd.wr as defined by the kernel's amd_umc
+	// PMU format: event=0x0a with rdwrmask=1 and rdwrmask=2.
+	if got, want := amdUMCConfig(false), uint64(0x10a); got != want {
+		t.Errorf("read config: want %#x, got %#x", want, got)
+	}
+	if got, want := amdUMCConfig(true), uint64(0x20a); got != want {
+		t.Errorf("write config: want %#x, got %#x", want, got)
+	}
+}
+
+func TestAMDUMCBytes(t *testing.T) {
+	for casCmds, want := range map[uint64]uint64{
+		0:       0,
+		1:       64,
+		1000000: 64000000,
+	} {
+		if got := amdUMCBytes(casCmds); got != want {
+			t.Errorf("%d CAS commands: want %d bytes, got %d", casCmds, want, got)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nobluetooth
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type bluetoothCollector struct {
+	adapterInfo *prometheus.Desc
+	connections *prometheus.Desc
+	logger      *slog.Logger
+}
+
+func init() {
+	registerCollector("bluetooth", defaultDisabled, NewBluetoothCollector)
+}
+
+// NewBluetoothCollector returns a new Collector exposing Bluetooth adapters
+// and their connections.
+func NewBluetoothCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "bluetooth"
+
+	return &bluetoothCollector{
+		adapterInfo: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "adapter_info"),
+			"Bluetooth adapter present in /sys/class/bluetooth, value is always 1.",
+			[]string{"adapter"}, nil,
+		),
+		connections: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "connections"),
+			"Number of connections of the Bluetooth adapter.",
+			[]string{"adapter"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *bluetoothCollector) Update(ch chan<- prometheus.Metric) error {
+	entries, err := os.ReadDir(sysFilePath("class/bluetooth"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("no Bluetooth support")
+			return ErrNoData
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		adapter := entry.Name()
+		// Connections are registered as <adapter>:<handle> next to the adapter.
+		if strings.Contains(adapter, ":") {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.adapterInfo, prometheus.GaugeValue, 1, adapter)
+
+		conns, err := filepath.Glob(filepath.Join(sysFilePath("class/bluetooth"), adapter+":*"))
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(len(conns)), adapter)
+	}
+
+	return nil
+}
@@ -16,21 +16,62 @@
 package collector
 
 import (
+	"bufio"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/mdlayher/netlink"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
 )
 
 type bondingCollector struct {
-	slaves, active typedDesc
-	logger         *slog.Logger
+	slaves, active      typedDesc
+	lacpInfo, lacpPorts typedDesc
+	lacpPartnerOperKey  typedDesc
+	lacpPDUsReceived    typedDesc
+	lacpPDUsTransmitted typedDesc
+	logger              *slog.Logger
 }
 
+// bondingLACPStats holds the 802.3ad information of a bonding interface
+// from /proc/net/bonding/<master>.
+type bondingLACPStats struct {
+	lacpRate     string
+	aggregatorID string
+	partnerMAC   string
+	ports        int
+	slaves       map[string]*bondingLACPSlaveStats
+}
+
+type bondingLACPSlaveStats struct {
+	partnerOperKey *uint64
+}
+
+// bondingLACPDUs holds the LACPDU counters of a bonding slave.
+type bondingLACPDUs struct {
+	received, transmitted uint64
+}
+
+// Constants from include/uapi/linux/if_link.h.
+const (
+	ifStatsMsgLen = 12
+
+	linkXstatsTypeBond = 2
+	bondXstats3AD      = 1
+
+	bond3ADStatLACPDURx = 0
+	bond3ADStatLACPDUTx = 1
+)
+
 func init() {
 	registerCollector("bonding", defaultEnabled, NewBondingCollector)
 }
@@ -49,6 +90,31 @@ func NewBondingCollector(logger *slog.Logger) (Collector, error) {
 			"Number of active slaves per bonding interface.",
 			[]string{"master"}, nil,
 		), prometheus.GaugeValue},
+		lacpInfo: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "bonding", "lacp_info"),
+			"802.3ad information of the active aggregator per bonding interface, value is always 1.",
+			[]string{"master", "lacp_rate", "aggregator_id", "partner_mac"}, nil,
+		), prometheus.GaugeValue},
+		lacpPorts: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "bonding", "lacp_ports"),
+			"Number of ports in the active 802.3ad aggregator per bonding interface.",
+			[]string{"master"}, nil,
+		), prometheus.GaugeValue},
+		lacpPartnerOperKey: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "bonding", "lacp_partner_oper_key"),
+			"Operational key of the 802.3ad link partner per bonding slave.",
+			[]string{"master", "slave"}, nil,
+		), prometheus.GaugeValue},
+		lacpPDUsReceived: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "bonding", "lacp_pdus_received_total"),
+			"Number of LACPDUs received per bonding slave.",
+			[]string{"master", "slave"}, nil,
+		), prometheus.CounterValue},
+		lacpPDUsTransmitted: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "bonding", "lacp_pdus_transmitted_total"),
+			"Number of LACPDUs transmitted per bonding slave.",
+			[]string{"master", "slave"}, nil,
+		), prometheus.CounterValue},
 		logger: logger,
 	}, nil
 }
@@ -67,10 +133,217 @@ func (c *bondingCollector) Update(ch chan<- prometheus.Metric) error {
 	for master, status := range bondingStats {
 		ch <- c.slaves.mustNewConstMetric(float64(status[0]), master)
 		ch <- c.active.mustNewConstMetric(float64(status[1]), master)
+		c.updateLACP(ch, master)
 	}
 	return nil
 }
 
+func (c *bondingCollector) updateLACP(ch chan<- prometheus.Metric, master string) {
+	path := procFilePath(filepath.Join("net/bonding", master))
+	file, err := os.Open(path)
+	if err != nil {
+		c.logger.Debug("Not collecting LACP stats", "file", path, "err", err)
+		return
+	}
+	defer file.Close()
+
+	lacp, err := parseBondingLACP(file)
+	if err != nil {
+		c.logger.Debug("Failed to parse LACP stats", "file", path, "err", err)
+		return
+	}
+	if lacp == nil {
+		// Not in 802.3ad mode.
+		return
+	}
+
+	ch <- c.lacpInfo.mustNewConstMetric(1, master, lacp.lacpRate, lacp.aggregatorID, lacp.partnerMAC)
+	ch <- c.lacpPorts.mustNewConstMetric(float64(lacp.ports), master)
+	for slave, stats := range lacp.slaves {
+		if stats.partnerOperKey != nil {
+			ch <- c.lacpPartnerOperKey.mustNewConstMetric(float64(*stats.partnerOperKey), master, slave)
+		}
+	}
+
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		c.logger.Debug("Not collecting LACPDU counters", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	for slave := range lacp.slaves {
+		iface, err := net.InterfaceByName(slave)
+		if err != nil {
+			c.logger.Debug("Not collecting LACPDU counters", "slave", slave, "err", err)
+			continue
+		}
+		pdus, err := readBondingLACPDUs(conn, iface.Index)
+		if err != nil {
+			c.logger.Debug("Failed to read LACPDU counters", "slave", slave, "err", err)
+			continue
+		}
+		if pdus == nil {
+			continue
+		}
+		ch <- c.lacpPDUsReceived.mustNewConstMetric(float64(pdus.received), master, slave)
+		ch <- c.lacpPDUsTransmitted.mustNewConstMetric(float64(pdus.transmitted), master, slave)
+	}
+}
+
+// parseBondingLACP parses /proc/net/bonding/<master>, returning nil if the
+// bonding interface isn't in 802.3ad mode. LACPDU counters aren't part of
+// this file, they are read with readBondingLACPDUs.
+func parseBondingLACP(r io.Reader) (*bondingLACPStats, error) {
+	var (
+		lacp    *bondingLACPStats
+		slave   *bondingLACPSlaveStats
+		partner bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "Bonding Mode":
+			if !strings.Contains(value, "802.3ad") {
+				return nil, nil
+			}
+			lacp = &bondingLACPStats{slaves: map[string]*bondingLACPSlaveStats{}}
+			continue
+		case "Slave Interface":
+			if lacp == nil {
+				return nil, nil
+			}
+			slave = &bondingLACPSlaveStats{}
+			lacp.slaves[value] = slave
+			partner = false
+			continue
+		}
+		if lacp == nil {
+			continue
+		}
+
+		if slave == nil {
+			switch key {
+			case "LACP rate":
+				lacp.lacpRate = value
+			case "Aggregator ID":
+				lacp.aggregatorID = value
+			case "Partner Mac Address":
+				lacp.partnerMAC = value
+			case "Number of ports":
+				ports, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid number of ports %q: %w", value, err)
+				}
+				lacp.ports = ports
+			}
+			continue
+		}
+
+		switch key {
+		case "details actor lacp pdu":
+			partner = false
+		case "details partner lacp pdu":
+			partner = true
+		case "oper key":
+			if partner {
+				key, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid partner oper key %q: %w", value, err)
+				}
+				slave.partnerOperKey = &key
+			}
+		}
+	}
+
+	return lacp, scanner.Err()
+}
+
+// readBondingLACPDUs requests the bond slave xstats of an interface with
+// RTM_GETSTATS. It returns nil if the interface isn't a slave of an 802.3ad
+// bond.
+func readBondingLACPDUs(conn *netlink.Conn, ifindex int) (*bondingLACPDUs, error) {
+	// struct if_stats_msg, asking only for IFLA_STATS_LINK_XSTATS_SLAVE.
+	req := make([]byte, ifStatsMsgLen)
+	binary.NativeEndian.PutUint32(req[4:8], uint32(ifindex))
+	binary.NativeEndian.PutUint32(req[8:12], 1<<(unix.IFLA_STATS_LINK_XSTATS_SLAVE-1))
+
+	msgs, err := conn.Execute(netlink.Message{
+		Header: netlink.Header{Type: unix.RTM_GETSTATS, Flags: netlink.Request},
+		Data:   req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range msgs {
+		if len(m.Data) < ifStatsMsgLen {
+			return nil, fmt.Errorf("short stats message of %d bytes", len(m.Data))
+		}
+		pdus, err := parseBondingLACPDUs(m.Data[ifStatsMsgLen:])
+		if err != nil || pdus != nil {
+			return pdus, err
+		}
+	}
+	return nil, nil
+}
+
+// parseBondingLACPDUs parses the attributes of an RTM_NEWSTATS message,
+// nested as IFLA_STATS_LINK_XSTATS_SLAVE > LINK_XSTATS_TYPE_BOND >
+// BOND_XSTATS_3AD > BOND_3AD_STAT_*.
+func parseBondingLACPDUs(b []byte) (*bondingLACPDUs, error) {
+	ad, err := netlink.NewAttributeDecoder(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var pdus *bondingLACPDUs
+	for ad.Next() {
+		if ad.Type() != unix.IFLA_STATS_LINK_XSTATS_SLAVE {
+			continue
+		}
+		ad.Nested(func(xad *netlink.AttributeDecoder) error {
+			for xad.Next() {
+				if xad.Type() != linkXstatsTypeBond {
+					continue
+				}
+				xad.Nested(func(bad *netlink.AttributeDecoder) error {
+					for bad.Next() {
+						if bad.Type() != bondXstats3AD {
+							continue
+						}
+						pdus = &bondingLACPDUs{}
+						bad.Nested(func(sad *netlink.AttributeDecoder) error {
+							for sad.Next() {
+								switch sad.Type() {
+								case bond3ADStatLACPDURx:
+									pdus.received = sad.Uint64()
+								case bond3ADStatLACPDUTx:
+									pdus.transmitted = sad.Uint64()
+								}
+							}
+							return nil
+						})
+					}
+					return nil
+				})
+			}
+			return nil
+		})
+	}
+	if err := ad.Err(); err != nil {
+		return nil, err
+	}
+	return pdus, nil
+}
+
 func readBondingStats(root string) (status map[string][2]int, err error) {
 	status = map[string][2]int{}
 	masters, err := os.ReadFile(filepath.Join(root, "bonding_masters"))
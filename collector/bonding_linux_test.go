@@ -16,7 +16,12 @@
 package collector
 
 import (
+	"os"
+	"strings"
 	"testing"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
 )
 
 func TestBonding(t *testing.T) {
@@ -36,3 +41,96 @@ func TestBonding(t *testing.T) {
 		t.Fatal("dmz in unexpected state")
 	}
 }
+
+func TestBondingLACP(t *testing.T) {
+	file, err := os.Open("fixtures/proc/net/bonding/int")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	lacp, err := parseBondingLACP(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lacp == nil {
+		t.Fatal("int not detected as 802.3ad bond")
+	}
+	if lacp.lacpRate != "fast" || lacp.aggregatorID != "1" || lacp.partnerMAC != "00:11:22:33:44:55" || lacp.ports != 1 {
+		t.Fatalf("unexpected aggregator info: %+v", lacp)
+	}
+
+	for slave, want := range map[string]uint64{
+		"eth1": 32773,
+		"eth5": 1,
+	} {
+		stats, ok := lacp.slaves[slave]
+		if !ok {
+			t.Fatalf("missing slave %s", slave)
+		}
+		if stats.partnerOperKey == nil {
+			t.Fatalf("slave %s: missing partner oper key", slave)
+		}
+		if got := *stats.partnerOperKey; got != want {
+			t.Errorf("slave %s: want %v, got %v", slave, want, got)
+		}
+	}
+
+	lacp, err = parseBondingLACP(strings.NewReader("Bonding Mode: fault-tolerance (active-backup)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lacp != nil {
+		t.Fatal("active-backup bond detected as 802.3ad")
+	}
+}
+
+func TestParseBondingLACPDUs(t *testing.T) {
+	ae := netlink.NewAttributeEncoder()
+	ae.Nested(unix.IFLA_STATS_LINK_XSTATS_SLAVE, func(xae *netlink.AttributeEncoder) error {
+		xae.Nested(linkXstatsTypeBond, func(bae *netlink.AttributeEncoder) error {
+			bae.Nested(bondXstats3AD, func(sae *netlink.AttributeEncoder) error {
+				sae.Uint64(bond3ADStatLACPDURx, 1234)
+				sae.Uint64(bond3ADStatLACPDUTx, 1240)
+				// BOND_3AD_STAT_LACPDU_UNKNOWN_RX
+				sae.Uint64(2, 7)
+				return nil
+			})
+			return nil
+		})
+		return nil
+	})
+	b, err := ae.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pdus, err := parseBondingLACPDUs(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pdus == nil {
+		t.Fatal("missing LACPDU counters")
+	}
+	if want := (bondingLACPDUs{received: 1234, transmitted: 1240}); *pdus != want {
+		t.Errorf("want %+v, got %+v", want, *pdus)
+	}
+
+	// Slaves of bonds in other modes have no BOND_XSTATS_3AD attribute.
+	ae = netlink.NewAttributeEncoder()
+	ae.Nested(unix.IFLA_STATS_LINK_XSTATS_SLAVE, func(xae *netlink.AttributeEncoder) error {
+		xae.Nested(linkXstatsTypeBond, func(*netlink.AttributeEncoder) error { return nil })
+		return nil
+	})
+	b, err = ae.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdus, err = parseBondingLACPDUs(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pdus != nil {
+		t.Errorf("want no LACPDU counters, got %+v", *pdus)
+	}
+}
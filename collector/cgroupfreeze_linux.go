@@ -0,0 +1,153 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupfreeze
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var cgroupFreezeInclude = kingpin.Flag("collector.cgroupfreeze.cgroup-include", "Regexp of cgroup paths to include, e.g. /user.slice/.+.").Default(".+").String()
+
+type cgroupFreezeCollector struct {
+	frozen  *prometheus.Desc
+	include *regexp.Regexp
+	logger  *slog.Logger
+}
+
+func init() {
+	registerCollector("cgroupfreeze", defaultDisabled, NewCgroupFreezeCollector)
+}
+
+// NewCgroupFreezeCollector returns a new Collector exposing the cgroup freezer state.
+func NewCgroupFreezeCollector(logger *slog.Logger) (Collector, error) {
+	include, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *cgroupFreezeInclude))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --collector.cgroupfreeze.cgroup-include: %w", err)
+	}
+
+	return &cgroupFreezeCollector{
+		frozen: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cgroup", "frozen"),
+			"Whether the cgroup is frozen (1) or thawed (0).",
+			[]string{"cgroup"}, nil,
+		),
+		include: include,
+		logger:  logger,
+	}, nil
+}
+
+func (c *cgroupFreezeCollector) Update(ch chan<- prometheus.Metric) error {
+	root := sysFilePath("fs/cgroup")
+
+	// On the unified hierarchy (cgroup v2) the frozen key of cgroup.events
+	// reports whether every process of a cgroup was frozen, cgroup.freeze
+	// only holds the requested state. On cgroup v1 the freezer controller has
+	// its own hierarchy with freezer.state files.
+	readState := readCgroupEventsFrozen
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		root = filepath.Join(root, "freezer")
+		readState = readCgroupFreezerState
+	}
+
+	found := false
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroups can be removed while walking the hierarchy.
+			if errors.Is(err, fs.ErrNotExist) && path != root {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		cgroup := filepath.Join("/", rel)
+		if !c.include.MatchString(cgroup) {
+			return nil
+		}
+
+		frozen, err := readState(path)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				c.logger.Debug("failed to read cgroup freezer state", "cgroup", cgroup, "err", err)
+			}
+			return nil
+		}
+
+		found = true
+		ch <- prometheus.MustNewConstMetric(c.frozen, prometheus.GaugeValue, frozen, cgroup)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.logger.Debug("cgroup freezer not available", "path", root)
+			return ErrNoData
+		}
+		return err
+	}
+	if !found {
+		return ErrNoData
+	}
+
+	return nil
+}
+
+// readCgroupEventsFrozen reads the frozen key of cgroup.events (v2), the root
+// cgroup cannot be frozen and has no cgroup.events.
+func readCgroupEventsFrozen(dir string) (float64, error) {
+	events, err := readFlatKeyedFile(filepath.Join(dir, "cgroup.events"))
+	if err != nil {
+		return 0, err
+	}
+	frozen, ok := events["frozen"]
+	if !ok {
+		// Kernels before 5.2 have no cgroup v2 freezer.
+		return 0, fs.ErrNotExist
+	}
+	return float64(frozen), nil
+}
+
+// readCgroupFreezerState reads freezer.state (v1), cgroups which are still
+// FREEZING are reported as thawed.
+func readCgroupFreezerState(dir string) (float64, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "freezer.state"))
+	if err != nil {
+		return 0, err
+	}
+	switch state := strings.TrimSpace(string(data)); state {
+	case "FROZEN":
+		return 1, nil
+	case "THAWED", "FREEZING":
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unknown freezer state %q", state)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupfreeze
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCgroupFreezeCollector struct {
+	cc Collector
+}
+
+func (c testCgroupFreezeCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCgroupFreezeCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCgroupFreezeStats(t *testing.T) {
+	defer func(include string) { *cgroupFreezeInclude = include }(*cgroupFreezeInclude)
+
+	for _, tc := range []struct {
+		name     string
+		include  string
+		testcase string
+	}{
+		{
+			name:    "all",
+			include: ".+",
+			// machine.slice has cgroup.freeze set but is not frozen yet.
+			testcase: `# HELP node_cgroup_frozen Whether the cgroup is frozen (1) or thawed (0).
+			# TYPE node_cgroup_frozen gauge
+			node_cgroup_frozen{cgroup="/machine.slice"} 0
+			node_cgroup_frozen{cgroup="/system.slice"} 0
+			node_cgroup_frozen{cgroup="/system.slice/sshd.service"} 0
+			node_cgroup_frozen{cgroup="/user.slice"} 0
+			node_cgroup_frozen{cgroup="/user.slice/user-1000.slice"} 1
+			`,
+		},
+		{
+			name:    "include",
+			include: "/user.slice/.+",
+			testcase: `# HELP node_cgroup_frozen Whether the cgroup is frozen (1) or thawed (0).
+			# TYPE node_cgroup_frozen gauge
+			node_cgroup_frozen{cgroup="/user.slice/user-1000.slice"} 1
+			`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			*sysPath = "fixtures/sys"
+			*cgroupFreezeInclude = tc.include
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			c, err := NewCgroupFreezeCollector(logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reg := prometheus.NewRegistry()
+			reg.MustRegister(&testCgroupFreezeCollector{cc: c})
+
+			err = testutil.GatherAndCompare(reg, strings.NewReader(tc.testcase))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReadCgroupFreezerState(t *testing.T) {
+	for state, want := range map[string]float64{
+		"FROZEN\n":   1,
+		"FREEZING\n": 0,
+		"THAWED\n":   0,
+	} {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "freezer.state"), []byte(state), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := readCgroupFreezerState(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("state %q: want %v, got %v", state, want, got)
+		}
+	}
+}
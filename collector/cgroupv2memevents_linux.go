@@ -0,0 +1,122 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupv2memevents
+
+package collector
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var cgroupV2MemEventsMaxDepth = kingpin.Flag("collector.cgroupv2memevents.max-depth", "Maximum depth of the cgroup hierarchy to expose memory events for, 0 only exposes the top level cgroups.").Default("2").Int()
+
+type cgroupV2MemEventsCollector struct {
+	events      *prometheus.Desc
+	localEvents *prometheus.Desc
+	maxDepth    int
+	logger      *slog.Logger
+}
+
+func init() {
+	registerCollector("cgroupv2memevents", defaultDisabled, NewCgroupV2MemEventsCollector)
+}
+
+// NewCgroupV2MemEventsCollector returns a new Collector exposing the memory
+// events of cgroup v2 cgroups.
+func NewCgroupV2MemEventsCollector(logger *slog.Logger) (Collector, error) {
+	return &cgroupV2MemEventsCollector{
+		events: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cgroupv2", "memory_events_total"),
+			"Memory events of the cgroup and its descendants, from memory.events.",
+			[]string{"cgroup", "event_type"}, nil,
+		),
+		localEvents: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cgroupv2", "memory_events_local_total"),
+			"Memory events of the cgroup itself, from memory.events.local.",
+			[]string{"cgroup", "event_type"}, nil,
+		),
+		maxDepth: *cgroupV2MemEventsMaxDepth,
+		logger:   logger,
+	}, nil
+}
+
+func (c *cgroupV2MemEventsCollector) Update(ch chan<- prometheus.Metric) error {
+	root := sysFilePath("fs/cgroup")
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		c.logger.Debug("cgroup v2 unified hierarchy not mounted", "path", root)
+		return ErrNoData
+	}
+
+	found := false
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroups can be removed while walking the hierarchy.
+			if errors.Is(err, fs.ErrNotExist) && path != root {
+				return nil
+			}
+			return err
+		}
+		// The root cgroup has no memory.events.
+		if !d.IsDir() || path == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if strings.Count(rel, string(filepath.Separator)) > c.maxDepth {
+			return filepath.SkipDir
+		}
+		rel = filepath.Join("/", rel)
+
+		for _, f := range []struct {
+			file string
+			desc *prometheus.Desc
+		}{
+			{"memory.events", c.events},
+			{"memory.events.local", c.localEvents},
+		} {
+			events, err := readFlatKeyedFile(filepath.Join(path, f.file))
+			if err != nil {
+				// The memory controller may not be enabled for this cgroup.
+				if !errors.Is(err, fs.ErrNotExist) {
+					c.logger.Debug("failed to read memory events", "cgroup", rel, "file", f.file, "err", err)
+				}
+				continue
+			}
+			found = true
+			for event, v := range events {
+				ch <- prometheus.MustNewConstMetric(f.desc, prometheus.CounterValue, float64(v), rel, event)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNoData
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupv2memevents
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCgroupV2MemEventsCollector struct {
+	cc Collector
+}
+
+func (c testCgroupV2MemEventsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCgroupV2MemEventsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCgroupV2MemEvents(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		maxDepth int
+		expected string
+	}{
+		{
+			// /user.slice/user-1000.slice/user@1000.service/app.slice is
+			// below the default depth.
+			name:     "default depth",
+			maxDepth: 2,
+			expected: `# HELP node_cgroupv2_memory_events_local_total Memory events of the cgroup itself, from memory.events.local.
+			# TYPE node_cgroupv2_memory_events_local_total counter
+			node_cgroupv2_memory_events_local_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="high"} 5
+			node_cgroupv2_memory_events_local_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="low"} 0
+			node_cgroupv2_memory_events_local_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="max"} 1
+			node_cgroupv2_memory_events_local_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="oom"} 0
+			node_cgroupv2_memory_events_local_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="oom_kill"} 0
+			# HELP node_cgroupv2_memory_events_total Memory events of the cgroup and its descendants, from memory.events.
+			# TYPE node_cgroupv2_memory_events_total counter
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="high"} 12
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="low"} 0
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="max"} 3
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="oom"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="oom_kill"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice",event_type="high"} 12
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice",event_type="low"} 0
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice",event_type="max"} 3
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice",event_type="oom"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice",event_type="oom_kill"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="high"} 12
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="low"} 0
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="max"} 3
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="oom"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice/user-1000.slice/user@1000.service",event_type="oom_kill"} 1
+			`,
+		},
+		{
+			name:     "top level only",
+			maxDepth: 0,
+			expected: `# HELP node_cgroupv2_memory_events_total Memory events of the cgroup and its descendants, from memory.events.
+			# TYPE node_cgroupv2_memory_events_total counter
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="high"} 12
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="low"} 0
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="max"} 3
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="oom"} 1
+			node_cgroupv2_memory_events_total{cgroup="/user.slice",event_type="oom_kill"} 1
+			`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			*sysPath = "fixtures/sys"
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			c, err := NewCgroupV2MemEventsCollector(logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.(*cgroupV2MemEventsCollector).maxDepth = tc.maxDepth
+			reg := prometheus.NewRegistry()
+			reg.MustRegister(&testCgroupV2MemEventsCollector{cc: c})
+
+			err = testutil.GatherAndCompare(reg, strings.NewReader(tc.expected))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupv2threads
+
+package collector
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type cgroupV2ThreadsCollector struct {
+	cpuUsage *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("cgroupv2threads", defaultDisabled, NewCgroupV2ThreadsCollector)
+}
+
+// NewCgroupV2ThreadsCollector returns a new Collector exposing CPU usage of
+// threaded cgroup v2 subtrees.
+func NewCgroupV2ThreadsCollector(logger *slog.Logger) (Collector, error) {
+	return &cgroupV2ThreadsCollector{
+		cpuUsage: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cgroupv2", "thread_cpu_usage_seconds_total"),
+			"CPU time consumed by a threaded cgroup, split by user and system mode.",
+			[]string{"cgroup", "thread_group", "mode"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *cgroupV2ThreadsCollector) Update(ch chan<- prometheus.Metric) error {
+	root := sysFilePath("fs/cgroup")
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		c.logger.Debug("cgroup v2 unified hierarchy not mounted", "path", root)
+		return ErrNoData
+	}
+
+	// domains tracks the threaded domain ("domain threaded") each directory
+	// belongs to. WalkDir visits parents before their children.
+	domains := make(map[string]string)
+	found := false
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// cgroups can be removed while walking the hierarchy.
+			if errors.Is(err, fs.ErrNotExist) && path != root {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+
+		cgroupType, err := os.ReadFile(filepath.Join(path, "cgroup.type"))
+		if err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.Join("/", rel)
+
+		switch strings.TrimSpace(string(cgroupType)) {
+		case "domain threaded":
+			domains[path] = rel
+		case "threaded":
+			domain := domains[filepath.Dir(path)]
+			domains[path] = domain
+
+			usage, err := readFlatKeyedFile(filepath.Join(path, "cpu.stat"))
+			if err != nil {
+				c.logger.Debug("failed to read cpu.stat", "cgroup", rel, "err", err)
+				return nil
+			}
+			found = true
+			for _, mode := range []string{"user", "system"} {
+				if v, ok := usage[mode+"_usec"]; ok {
+					ch <- prometheus.MustNewConstMetric(c.cpuUsage, prometheus.CounterValue, float64(v)/1e6, domain, rel, mode)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNoData
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocgroupv2threads
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCgroupV2ThreadsCollector struct {
+	cc Collector
+}
+
+func (c testCgroupV2ThreadsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCgroupV2ThreadsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCgroupV2ThreadsStats(t *testing.T) {
+	// Nested threaded cgroups belong to the closest "domain threaded" parent.
+	testcase := `# HELP node_cgroupv2_thread_cpu_usage_seconds_total CPU time consumed by a threaded cgroup, split by user and system mode.
+	# TYPE node_cgroupv2_thread_cpu_usage_seconds_total counter
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="system",thread_group="/system.slice/app.service/io"} 0.2
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="system",thread_group="/system.slice/app.service/workers"} 1.25
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="system",thread_group="/system.slice/app.service/workers/pool"} 0.5
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="user",thread_group="/system.slice/app.service/io"} 0.1
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="user",thread_group="/system.slice/app.service/workers"} 4
+	node_cgroupv2_thread_cpu_usage_seconds_total{cgroup="/system.slice/app.service",mode="user",thread_group="/system.slice/app.service/workers/pool"} 1
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewCgroupV2ThreadsCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testCgroupV2ThreadsCollector{cc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
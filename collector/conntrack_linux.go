@@ -16,6 +16,7 @@
 package collector
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -55,6 +56,16 @@ var (
 		"Maximum size of connection tracking table.",
 		nil, nil,
 	)
+	conntrackExpectCurrent = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "nf_conntrack_expect_entries"),
+		"Number of currently allocated expectation entries for connection tracking helpers.",
+		nil, nil,
+	)
+	conntrackExpectLimit = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "nf_conntrack_expect_entries_limit"),
+		"Maximum size of connection tracking expectation table.",
+		nil, nil,
+	)
 	conntrackFound = prometheus.NewDesc(
 		prometheus.BuildFQName(namespace, "", "nf_conntrack_stat_found"),
 		"Number of searched entries which were successful.",
@@ -119,6 +130,8 @@ func (c *conntrackCollector) Update(ch chan<- prometheus.Metric) error {
 	ch <- prometheus.MustNewConstMetric(
 		conntrackLimit, prometheus.GaugeValue, float64(value))
 
+	c.updateExpect(ch)
+
 	conntrackStats, err := getConntrackStatistics()
 	if err != nil {
 		return c.handleErr(err)
@@ -143,6 +156,27 @@ func (c *conntrackCollector) Update(ch chan<- prometheus.Metric) error {
 	return nil
 }
 
+// updateExpect exposes the expectation table used by conntrack helpers
+// (FTP, SIP, H.323, ...). It is only available with nf_conntrack loaded and
+// missing files are not treated as an error.
+func (c *conntrackCollector) updateExpect(ch chan<- prometheus.Metric) {
+	count, err := countConntrackExpectEntries()
+	if err != nil {
+		c.logger.Debug("failed to read conntrack expectation table", "err", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(
+			conntrackExpectCurrent, prometheus.GaugeValue, float64(count))
+	}
+
+	value, err := readUintFromFile(procFilePath("sys/net/netfilter/nf_conntrack_expect_max"))
+	if err != nil {
+		c.logger.Debug("failed to read conntrack expectation limit", "err", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(
+		conntrackExpectLimit, prometheus.GaugeValue, float64(value))
+}
+
 func (c *conntrackCollector) handleErr(err error) error {
 	if errors.Is(err, os.ErrNotExist) {
 		c.logger.Debug("conntrack probably not loaded")
@@ -177,3 +211,16 @@ func getConntrackStatistics() (*conntrackStatistics, error) {
 
 	return &s, nil
 }
+
+// countConntrackExpectEntries counts the entries of the expectation table,
+// falling back to the legacy ip_conntrack_expect file.
+func countConntrackExpectEntries() (int, error) {
+	data, err := os.ReadFile(procFilePath("net/nf_conntrack_expect"))
+	if errors.Is(err, os.ErrNotExist) {
+		data, err = os.ReadFile(procFilePath("net/ip_conntrack_expect"))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Count(data, []byte("\n")), nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocoredump
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	coredumpDirectory = kingpin.Flag("collector.coredump.directory", "Directory where systemd-coredump stores core dumps.").Default("/var/lib/systemd/coredump").String()
+)
+
+type coredumpCollector struct {
+	files         *prometheus.Desc
+	lastTimestamp *prometheus.Desc
+	logger        *slog.Logger
+}
+
+func init() {
+	registerCollector("coredump", defaultDisabled, NewCoredumpCollector)
+}
+
+// NewCoredumpCollector returns a new Collector exposing the core dumps stored by systemd-coredump.
+func NewCoredumpCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "coredump"
+
+	return &coredumpCollector{
+		files: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "files"),
+			"Number of core dumps stored by systemd-coredump.",
+			nil, nil,
+		),
+		lastTimestamp: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "last_timestamp_seconds"),
+			"Modification time of the most recent core dump in unixtime.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *coredumpCollector) Update(ch chan<- prometheus.Metric) error {
+	path := rootfsFilePath(*coredumpDirectory)
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("coredump directory not found", "path", path)
+			return ErrNoData
+		}
+		return fmt.Errorf("failed to read coredump directory %s: %w", path, err)
+	}
+
+	var (
+		count  int
+		latest int64
+	)
+	for _, entry := range entries {
+		// Core dumps are named core.<comm>.<uid>.<boot id>.<pid>.<usec>[.<compression>].
+		if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), "core.") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// Removed by systemd-tmpfiles in the meantime.
+				continue
+			}
+			return err
+		}
+		count++
+		latest = max(latest, info.ModTime().Unix())
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.files, prometheus.GaugeValue, float64(count))
+	if count > 0 {
+		ch <- prometheus.MustNewConstMetric(c.lastTimestamp, prometheus.GaugeValue, float64(latest))
+	}
+
+	return nil
+}
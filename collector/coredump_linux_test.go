@@ -0,0 +1,93 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocoredump
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCoredumpCollector struct {
+	cc Collector
+}
+
+func (c testCoredumpCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCoredumpCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCoredump(t *testing.T) {
+	// The temporary file of a core dump being written is not counted.
+	testcase := `# HELP node_coredump_files Number of core dumps stored by systemd-coredump.
+	# TYPE node_coredump_files gauge
+	node_coredump_files 2
+	`
+	defer func(path, dir string) { *rootfsPath, *coredumpDirectory = path, dir }(*rootfsPath, *coredumpDirectory)
+	*rootfsPath = "fixtures"
+	*coredumpDirectory = "/var/lib/systemd/coredump"
+
+	// The modification times of the fixtures depend on the checkout.
+	var latest int64
+	cores, err := filepath.Glob("fixtures/var/lib/systemd/coredump/core.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, core := range cores {
+		info, err := os.Stat(core)
+		if err != nil {
+			t.Fatal(err)
+		}
+		latest = max(latest, info.ModTime().Unix())
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewCoredumpCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testCoredumpCollector{cc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase), "node_coredump_files")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "node_coredump_last_timestamp_seconds" {
+			continue
+		}
+		if got := family.GetMetric()[0].GetGauge().GetValue(); got != float64(latest) {
+			t.Errorf("want last core dump at %d, got %v", latest, got)
+		}
+		return
+	}
+	t.Error("node_coredump_last_timestamp_seconds not found")
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocpu_cache
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type cpuCache struct {
+	level      string
+	cacheType  string
+	sizeKB     uint64
+	sharedCPUs string
+	lineSize   uint64
+	ways       uint64
+}
+
+type cpuCacheCollector struct {
+	info     *prometheus.Desc
+	lineSize *prometheus.Desc
+	ways     *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("cpu_cache", defaultDisabled, NewCPUCacheCollector)
+}
+
+// NewCPUCacheCollector returns a new Collector exposing the CPU cache topology.
+func NewCPUCacheCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "cpu_cache"
+
+	return &cpuCacheCollector{
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "info"),
+			"CPU cache information from /sys/devices/system/cpu/cpu*/cache, value is always 1.",
+			[]string{"level", "type", "size_kb", "shared_cpus"}, nil,
+		),
+		lineSize: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "coherency_line_size_bytes"),
+			"Size of a CPU cache line in bytes.",
+			[]string{"level", "type", "shared_cpus"}, nil,
+		),
+		ways: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "ways_of_associativity"),
+			"Number of ways of associativity of the CPU cache.",
+			[]string{"level", "type", "shared_cpus"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *cpuCacheCollector) Update(ch chan<- prometheus.Metric) error {
+	dirs, err := filepath.Glob(sysFilePath("devices/system/cpu/cpu[0-9]*/cache/index[0-9]*"))
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		c.logger.Debug("no CPU cache information found")
+		return ErrNoData
+	}
+
+	// Shared caches are listed below every CPU sharing them.
+	seen := make(map[[3]string]struct{})
+	for _, dir := range dirs {
+		cache, err := readCPUCache(dir)
+		if err != nil {
+			return err
+		}
+		key := [3]string{cache.level, cache.cacheType, cache.sharedCPUs}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		// A size or line size of 0 means the kernel didn't report it.
+		var size string
+		if cache.sizeKB > 0 {
+			size = strconv.FormatUint(cache.sizeKB, 10)
+		}
+		ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1,
+			cache.level, cache.cacheType, size, cache.sharedCPUs)
+		if cache.lineSize > 0 {
+			ch <- prometheus.MustNewConstMetric(c.lineSize, prometheus.GaugeValue, float64(cache.lineSize),
+				cache.level, cache.cacheType, cache.sharedCPUs)
+		}
+		// ways_of_associativity is 0 for fully associative caches and
+		// missing on some architectures.
+		if cache.ways > 0 {
+			ch <- prometheus.MustNewConstMetric(c.ways, prometheus.GaugeValue, float64(cache.ways),
+				cache.level, cache.cacheType, cache.sharedCPUs)
+		}
+	}
+
+	return nil
+}
+
+// readCPUCache reads a cacheinfo index directory. The kernel hides the level,
+// type, size and coherency_line_size attributes when they are unknown, for
+// example on arm64 systems without cache information in DT or ACPI.
+func readCPUCache(dir string) (cpuCache, error) {
+	var cache cpuCache
+
+	for _, attr := range []struct {
+		name     string
+		dst      *string
+		optional bool
+	}{
+		{"level", &cache.level, true},
+		{"type", &cache.cacheType, true},
+		{"shared_cpu_list", &cache.sharedCPUs, false},
+	} {
+		value, err := os.ReadFile(filepath.Join(dir, attr.name))
+		if err != nil {
+			if attr.optional && errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return cache, err
+		}
+		*attr.dst = strings.TrimSpace(string(value))
+	}
+
+	size, err := os.ReadFile(filepath.Join(dir, "size"))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return cache, err
+	default:
+		if cache.sizeKB, err = parseCPUCacheSize(strings.TrimSpace(string(size))); err != nil {
+			return cache, fmt.Errorf("invalid cache size in %s: %w", dir, err)
+		}
+	}
+
+	cache.lineSize, err = readUintFromFile(filepath.Join(dir, "coherency_line_size"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return cache, err
+	}
+	cache.ways, _ = readUintFromFile(filepath.Join(dir, "ways_of_associativity"))
+
+	return cache, nil
+}
+
+// parseCPUCacheSize parses cache sizes like "32K" to KiB.
+func parseCPUCacheSize(size string) (uint64, error) {
+	multiplier := uint64(1)
+	switch {
+	case strings.HasSuffix(size, "K"):
+		size = strings.TrimSuffix(size, "K")
+	case strings.HasSuffix(size, "M"):
+		size = strings.TrimSuffix(size, "M")
+		multiplier = 1024
+	}
+	v, err := strconv.ParseUint(size, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return v * multiplier, nil
+}
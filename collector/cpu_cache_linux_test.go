@@ -0,0 +1,90 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocpu_cache
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCPUCacheCollector struct {
+	cc Collector
+}
+
+func (c testCPUCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCPUCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCPUCache(t *testing.T) {
+	*sysPath = "fixtures/sys"
+
+	// cpu0/cache/index1 has no size, coherency_line_size or
+	// ways_of_associativity.
+	testcase := `# HELP node_cpu_cache_coherency_line_size_bytes Size of a CPU cache line in bytes.
+	# TYPE node_cpu_cache_coherency_line_size_bytes gauge
+	node_cpu_cache_coherency_line_size_bytes{level="1",shared_cpus="0",type="Data"} 64
+	node_cpu_cache_coherency_line_size_bytes{level="1",shared_cpus="1",type="Data"} 64
+	node_cpu_cache_coherency_line_size_bytes{level="3",shared_cpus="0-1",type="Unified"} 64
+	# HELP node_cpu_cache_info CPU cache information from /sys/devices/system/cpu/cpu*/cache, value is always 1.
+	# TYPE node_cpu_cache_info gauge
+	node_cpu_cache_info{level="1",shared_cpus="0",size_kb="",type="Instruction"} 1
+	node_cpu_cache_info{level="1",shared_cpus="0",size_kb="48",type="Data"} 1
+	node_cpu_cache_info{level="1",shared_cpus="1",size_kb="48",type="Data"} 1
+	node_cpu_cache_info{level="3",shared_cpus="0-1",size_kb="12288",type="Unified"} 1
+	# HELP node_cpu_cache_ways_of_associativity Number of ways of associativity of the CPU cache.
+	# TYPE node_cpu_cache_ways_of_associativity gauge
+	node_cpu_cache_ways_of_associativity{level="1",shared_cpus="0",type="Data"} 12
+	node_cpu_cache_ways_of_associativity{level="1",shared_cpus="1",type="Data"} 12
+	node_cpu_cache_ways_of_associativity{level="3",shared_cpus="0-1",type="Unified"} 12
+	`
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewCPUCacheCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testCPUCacheCollector{cc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseCPUCacheSize(t *testing.T) {
+	for size, want := range map[string]uint64{
+		"48K": 48,
+		"12M": 12288,
+	} {
+		got, err := parseCPUCacheSize(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("size %q: want %d KiB, got %d", size, want, got)
+		}
+	}
+}
@@ -35,6 +35,11 @@ var (
 		"Maximum CPU thread frequency in hertz.",
 		[]string{"cpu"}, nil,
 	)
+	cpuFreqBaseDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, cpuCollectorSubsystem, "frequency_base_hertz"),
+		"Base (non-turbo) CPU thread frequency in hertz.",
+		[]string{"cpu"}, nil,
+	)
 	cpuFreqScalingFreqDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(namespace, cpuCollectorSubsystem, "scaling_frequency_hertz"),
 		"Current scaled CPU thread frequency in hertz.",
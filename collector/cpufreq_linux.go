@@ -16,8 +16,11 @@
 package collector
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -80,6 +83,14 @@ func (c *cpuFreqCollector) Update(ch chan<- prometheus.Metric) error {
 				stats.Name,
 			)
 		}
+		if base, ok := c.baseFrequency(stats.Name); ok {
+			ch <- prometheus.MustNewConstMetric(
+				cpuFreqBaseDesc,
+				prometheus.GaugeValue,
+				float64(base)*1000.0,
+				stats.Name,
+			)
+		}
 		if stats.ScalingCurrentFrequency != nil {
 			ch <- prometheus.MustNewConstMetric(
 				cpuFreqScalingFreqDesc,
@@ -121,5 +132,19 @@ func (c *cpuFreqCollector) Update(ch chan<- prometheus.Metric) error {
 			}
 		}
 	}
+
 	return nil
 }
+
+// baseFrequency returns the base_frequency of a CPU in kHz. It is only
+// provided by some drivers, e.g. intel_pstate.
+func (c *cpuFreqCollector) baseFrequency(cpu string) (uint64, bool) {
+	base, err := readUintFromFile(sysFilePath(filepath.Join("devices/system/cpu", "cpu"+cpu, "cpufreq", "base_frequency")))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("Couldn't read base frequency", "cpu", cpu, "err", err)
+		}
+		return 0, false
+	}
+	return base, true
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocpufreq_stats
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs/sysfs"
+)
+
+// time_in_state is reported in units of USER_HZ, which is 100 on all
+// architectures supported by Linux.
+const cpuFreqStatsTicksPerSecond = 100.0
+
+type cpuFreqStatsCollector struct {
+	fs          sysfs.FS
+	transitions *prometheus.Desc
+	timeInState *prometheus.Desc
+	logger      *slog.Logger
+}
+
+func init() {
+	registerCollector("cpufreq_stats", defaultDisabled, NewCPUFreqStatsCollector)
+}
+
+// NewCPUFreqStatsCollector returns a new Collector exposing the cpufreq
+// statistics of CONFIG_CPU_FREQ_STAT.
+func NewCPUFreqStatsCollector(logger *slog.Logger) (Collector, error) {
+	fs, err := sysfs.NewFS(*sysPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sysfs: %w", err)
+	}
+
+	const subsystem = "cpu"
+
+	return &cpuFreqStatsCollector{
+		fs: fs,
+		transitions: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "frequency_transitions_total"),
+			"Number of CPU frequency transitions between two frequencies, from cpufreq/stats/trans_table.",
+			[]string{"cpu", "from_khz", "to_khz"}, nil,
+		),
+		timeInState: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "time_in_state_seconds_total"),
+			"Seconds the CPU spent at each frequency, from cpufreq/stats/time_in_state.",
+			[]string{"cpu", "frequency_khz"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *cpuFreqStatsCollector) Update(ch chan<- prometheus.Metric) error {
+	cpuFreqs, err := c.fs.SystemCpufreq()
+	if err != nil {
+		return err
+	}
+
+	for _, stats := range cpuFreqs {
+		if stats.CpuinfoFrequencyDuration != nil {
+			for freq, ticks := range *stats.CpuinfoFrequencyDuration {
+				ch <- prometheus.MustNewConstMetric(c.timeInState, prometheus.CounterValue,
+					float64(ticks)/cpuFreqStatsTicksPerSecond,
+					stats.Name, strconv.FormatUint(freq, 10))
+			}
+		}
+
+		// The first row holds the target frequencies, each following row
+		// starts with the source frequency. The diagonal is always zero.
+		if stats.CpuinfoTransitionTable == nil || len(*stats.CpuinfoTransitionTable) == 0 {
+			continue
+		}
+		table := *stats.CpuinfoTransitionTable
+		to := table[0]
+		for _, row := range table[1:] {
+			from := strconv.FormatUint(row[0], 10)
+			for i := 1; i < len(row) && i < len(to); i++ {
+				if row[0] == to[i] {
+					continue
+				}
+				ch <- prometheus.MustNewConstMetric(c.transitions, prometheus.CounterValue,
+					float64(row[i]),
+					stats.Name, from, strconv.FormatUint(to[i], 10))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocpufreq_stats
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCPUFreqStatsCollector struct {
+	cc Collector
+}
+
+func (c testCPUFreqStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCPUFreqStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCPUFreqStats(t *testing.T) {
+	testcase := `# HELP node_cpu_frequency_transitions_total Number of CPU frequency transitions between two frequencies, from cpufreq/stats/trans_table.
+	# TYPE node_cpu_frequency_transitions_total counter
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="2200000",to_khz="2800000"} 10
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="2200000",to_khz="3600000"} 7
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="2800000",to_khz="2200000"} 12
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="2800000",to_khz="3600000"} 8
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="3600000",to_khz="2200000"} 5
+	node_cpu_frequency_transitions_total{cpu="0",from_khz="3600000",to_khz="2800000"} 10
+	# HELP node_cpu_time_in_state_seconds_total Seconds the CPU spent at each frequency, from cpufreq/stats/time_in_state.
+	# TYPE node_cpu_time_in_state_seconds_total counter
+	node_cpu_time_in_state_seconds_total{cpu="0",frequency_khz="2200000"} 83.9
+	node_cpu_time_in_state_seconds_total{cpu="0",frequency_khz="2800000"} 1.72
+	node_cpu_time_in_state_seconds_total{cpu="0",frequency_khz="3600000"} 20.89
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewCPUFreqStatsCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testCPUFreqStatsCollector{cc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocrypto
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+type cryptoCollector struct {
+	fs             procfs.FS
+	info           *prometheus.Desc
+	selftestFailed *prometheus.Desc
+	logger         *slog.Logger
+}
+
+func init() {
+	registerCollector("crypto", defaultDisabled, NewCryptoCollector)
+}
+
+// NewCryptoCollector returns a new Collector exposing the kernel crypto
+// algorithms from /proc/crypto.
+func NewCryptoCollector(logger *slog.Logger) (Collector, error) {
+	fs, err := procfs.NewFS(*procPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open procfs: %w", err)
+	}
+
+	return &cryptoCollector{
+		fs: fs,
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "crypto", "algorithm_info"),
+			"Kernel crypto algorithm implementations from /proc/crypto, value is always 1.",
+			[]string{"name", "driver", "module", "type", "priority", "selftest"}, nil,
+		),
+		selftestFailed: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "crypto", "selftest_failed"),
+			"Number of kernel crypto algorithm implementations which failed their selftest.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *cryptoCollector) Update(ch chan<- prometheus.Metric) error {
+	algorithms, err := c.fs.Crypto()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("crypto API not available")
+			return ErrNoData
+		}
+		return fmt.Errorf("failed to read /proc/crypto: %w", err)
+	}
+
+	var failed int
+	seen := make(map[[6]string]struct{}, len(algorithms))
+	for _, a := range algorithms {
+		var priority string
+		if a.Priority != nil {
+			priority = strconv.FormatInt(*a.Priority, 10)
+		}
+		labels := [6]string{a.Name, a.Driver, a.Module, a.Type, priority, a.Selftest}
+		if _, ok := seen[labels]; ok {
+			continue
+		}
+		seen[labels] = struct{}{}
+		if a.Selftest == "failed" {
+			failed++
+		}
+		ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1, labels[:]...)
+	}
+	ch <- prometheus.MustNewConstMetric(c.selftestFailed, prometheus.GaugeValue, float64(failed))
+
+	return nil
+}
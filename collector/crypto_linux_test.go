@@ -0,0 +1,65 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nocrypto
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testCryptoCollector struct {
+	cc Collector
+}
+
+func (c testCryptoCollector) Collect(ch chan<- prometheus.Metric) {
+	c.cc.Update(ch)
+}
+
+func (c testCryptoCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestCryptoStats(t *testing.T) {
+	// cbc-aes-ccp is listed twice and only counted once.
+	testcase := `# HELP node_crypto_algorithm_info Kernel crypto algorithm implementations from /proc/crypto, value is always 1.
+	# TYPE node_crypto_algorithm_info gauge
+	node_crypto_algorithm_info{driver="cbc-aes-ccp",module="ccp_crypto",name="cbc(aes)",priority="300",selftest="failed",type="skcipher"} 1
+	node_crypto_algorithm_info{driver="ccm_base(ctr(aes-aesni),cbcmac(aes-aesni))",module="ccm",name="ccm(aes)",priority="300",selftest="passed",type="aead"} 1
+	node_crypto_algorithm_info{driver="sha256-avx2",module="sha256_ssse3",name="sha256",priority="170",selftest="passed",type="shash"} 1
+	# HELP node_crypto_selftest_failed Number of kernel crypto algorithm implementations which failed their selftest.
+	# TYPE node_crypto_selftest_failed gauge
+	node_crypto_selftest_failed 1
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewCryptoCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testCryptoCollector{cc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
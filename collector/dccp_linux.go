@@ -0,0 +1,76 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodccp
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+type dccpCollector struct {
+	fs      procfs.FS
+	sockets *prometheus.Desc
+	logger  *slog.Logger
+}
+
+func init() {
+	registerCollector("dccp", defaultDisabled, NewDCCPCollector)
+}
+
+// NewDCCPCollector returns a new Collector exposing DCCP socket usage.
+func NewDCCPCollector(logger *slog.Logger) (Collector, error) {
+	fs, err := procfs.NewFS(*procPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open procfs: %w", err)
+	}
+
+	return &dccpCollector{
+		fs: fs,
+		sockets: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "dccp", "sockets_inuse"),
+			"Number of DCCP sockets in use, from /proc/net/protocols.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *dccpCollector) Update(ch chan<- prometheus.Metric) error {
+	protocols, err := c.fs.NetProtocols()
+	if err != nil {
+		return fmt.Errorf("couldn't get protocols: %w", err)
+	}
+
+	// DCCP is only listed once the dccp and dccp_ipv6 modules are loaded.
+	var sockets int64
+	found := false
+	for _, name := range []string{"DCCP", "DCCPv6"} {
+		if p, ok := protocols[name]; ok {
+			sockets += p.Sockets
+			found = true
+		}
+	}
+	if !found {
+		c.logger.Debug("DCCP not available")
+		return ErrNoData
+	}
+	ch <- prometheus.MustNewConstMetric(c.sockets, prometheus.GaugeValue, float64(sockets))
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodiskerrors
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type diskErrorsCollector struct {
+	counters []diskErrorsCounter
+	logger   *slog.Logger
+}
+
+type diskErrorsCounter struct {
+	file string
+	desc *prometheus.Desc
+}
+
+func init() {
+	registerCollector("diskerrors", defaultDisabled, NewDiskErrorsCollector)
+}
+
+// NewDiskErrorsCollector returns a new Collector exposing the I/O counters of
+// SCSI devices.
+func NewDiskErrorsCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "disk"
+
+	return &diskErrorsCollector{
+		counters: []diskErrorsCounter{
+			{"ioerr_cnt", prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, subsystem, "scsi_io_errors_total"),
+				"Number of SCSI commands completed with an error.",
+				[]string{"device"}, nil,
+			)},
+			{"iotmo_cnt", prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, subsystem, "scsi_io_timeouts_total"),
+				"Number of SCSI commands that timed out.",
+				[]string{"device"}, nil,
+			)},
+			{"iodone_cnt", prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, subsystem, "scsi_io_done_total"),
+				"Number of completed SCSI commands.",
+				[]string{"device"}, nil,
+			)},
+		},
+		logger: logger,
+	}, nil
+}
+
+func (c *diskErrorsCollector) Update(ch chan<- prometheus.Metric) error {
+	// Only SCSI devices (including SATA and SAS disks) have these counters.
+	devices, err := filepath.Glob(sysFilePath("block/*/device/ioerr_cnt"))
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		c.logger.Debug("no SCSI devices found")
+		return ErrNoData
+	}
+
+	for _, path := range devices {
+		dir := filepath.Dir(path)
+		device := filepath.Base(filepath.Dir(dir))
+		for _, counter := range c.counters {
+			value, err := readSCSICounter(filepath.Join(dir, counter.file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(counter.desc, prometheus.CounterValue, float64(value), device)
+		}
+	}
+
+	return nil
+}
+
+// readSCSICounter reads a SCSI device counter, which the kernel prints in
+// hexadecimal ("0x1f").
+func readSCSICounter(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(data)), 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s: %w", path, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodiskerrors
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testDiskErrorsCollector struct {
+	dc Collector
+}
+
+func (c testDiskErrorsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.dc.Update(ch)
+}
+
+func (c testDiskErrorsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestDiskErrors(t *testing.T) {
+	testcase := `# HELP node_disk_scsi_io_done_total Number of completed SCSI commands.
+	# TYPE node_disk_scsi_io_done_total counter
+	node_disk_scsi_io_done_total{device="sda"} 6699
+	# HELP node_disk_scsi_io_errors_total Number of SCSI commands completed with an error.
+	# TYPE node_disk_scsi_io_errors_total counter
+	node_disk_scsi_io_errors_total{device="sda"} 31
+	# HELP node_disk_scsi_io_timeouts_total Number of SCSI commands that timed out.
+	# TYPE node_disk_scsi_io_timeouts_total counter
+	node_disk_scsi_io_timeouts_total{device="sda"} 2
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewDiskErrorsCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testDiskErrorsCollector{dc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodisk_writeback
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	diskWritebackDeviceInclude    = kingpin.Flag("collector.disk_writeback.device-include", "Regexp of block devices to include (mutually exclusive to device-exclude).").String()
+	diskWritebackDeviceExcludeSet bool
+	diskWritebackDeviceExclude    = kingpin.Flag("collector.disk_writeback.device-exclude", "Regexp of block devices to exclude (mutually exclusive to device-include).").Default("^(z?ram|loop|fd)\\d+$").PreAction(func(c *kingpin.ParseContext) error {
+		diskWritebackDeviceExcludeSet = true
+		return nil
+	}).String()
+)
+
+type diskWritebackCollector struct {
+	writeCacheEnabled *prometheus.Desc
+	deviceFilter      deviceFilter
+	logger            *slog.Logger
+}
+
+func init() {
+	registerCollector("disk_writeback", defaultDisabled, NewDiskWritebackCollector)
+}
+
+// NewDiskWritebackCollector returns a new Collector exposing the write cache
+// mode of block devices.
+func NewDiskWritebackCollector(logger *slog.Logger) (Collector, error) {
+	exclude := *diskWritebackDeviceExclude
+	if *diskWritebackDeviceInclude != "" {
+		if diskWritebackDeviceExcludeSet {
+			return nil, errors.New("--collector.disk_writeback.device-exclude and --collector.disk_writeback.device-include are mutually exclusive")
+		}
+		exclude = ""
+	}
+
+	return &diskWritebackCollector{
+		writeCacheEnabled: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "block_device", "writecache_enabled"),
+			"Whether the block device uses a volatile write-back cache (1) or write-through (0).",
+			[]string{"device"}, nil,
+		),
+		deviceFilter: newDeviceFilter(exclude, *diskWritebackDeviceInclude),
+		logger:       logger,
+	}, nil
+}
+
+func (c *diskWritebackCollector) Update(ch chan<- prometheus.Metric) error {
+	devices, err := os.ReadDir(sysFilePath("block"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrNoData
+		}
+		return err
+	}
+
+	for _, d := range devices {
+		device := d.Name()
+		if c.deviceFilter.ignored(device) {
+			continue
+		}
+		data, err := os.ReadFile(sysFilePath(filepath.Join("block", device, "queue/write_cache")))
+		switch {
+		case err == nil:
+			enabled, err := parseWriteCacheMode(strings.TrimSpace(string(data)))
+			if err != nil {
+				c.logger.Debug("unknown write cache mode", "device", device, "err", err)
+				break
+			}
+			ch <- prometheus.MustNewConstMetric(c.writeCacheEnabled, prometheus.GaugeValue, enabled, device)
+		case errors.Is(err, os.ErrNotExist):
+		default:
+			return fmt.Errorf("failed to read write cache mode of %s: %w", device, err)
+		}
+	}
+
+	return nil
+}
+
+// parseWriteCacheMode converts the content of queue/write_cache into 1 for
+// write-back and 0 for write-through.
+func parseWriteCacheMode(mode string) (float64, error) {
+	switch mode {
+	case "write back", "on":
+		return 1, nil
+	case "write through", "off":
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unknown write cache mode %q", mode)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodisk_writeback
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testDiskWritebackCollector struct {
+	dc Collector
+}
+
+func (c testDiskWritebackCollector) Collect(ch chan<- prometheus.Metric) {
+	c.dc.Update(ch)
+}
+
+func (c testDiskWritebackCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestDiskWriteback(t *testing.T) {
+	testcase := `# HELP node_block_device_writecache_enabled Whether the block device uses a volatile write-back cache (1) or write-through (0).
+	# TYPE node_block_device_writecache_enabled gauge
+	node_block_device_writecache_enabled{device="md0"} 0
+	node_block_device_writecache_enabled{device="sda"} 1
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewDiskWritebackCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testDiskWritebackCollector{dc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiskWritebackDeviceFilterExclusive(t *testing.T) {
+	include, excludeSet := *diskWritebackDeviceInclude, diskWritebackDeviceExcludeSet
+	defer func() {
+		*diskWritebackDeviceInclude, diskWritebackDeviceExcludeSet = include, excludeSet
+	}()
+	*diskWritebackDeviceInclude, diskWritebackDeviceExcludeSet = "^sd", true
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if _, err := NewDiskWritebackCollector(logger); err == nil {
+		t.Error("expected an error with both device-include and device-exclude set")
+	}
+}
+
+func TestParseWriteCacheMode(t *testing.T) {
+	for mode, want := range map[string]float64{
+		"write back":    1,
+		"on":            1,
+		"write through": 0,
+		"off":           0,
+	} {
+		got, err := parseWriteCacheMode(mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%q: want %v, got %v", mode, want, got)
+		}
+	}
+
+	if _, err := parseWriteCacheMode("unknown"); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
@@ -153,6 +153,9 @@ func NewDiskstatsCollector(logger *slog.Logger) (Collector, error) {
 			{
 				desc: ioTimeSecondsDesc, valueType: prometheus.CounterValue,
 			},
+			// The queue saturation of a device is
+			// rate(node_disk_io_time_weighted_seconds_total[5m]), the average
+			// number of I/Os in flight, see the node-mixin recording rules.
 			{
 				desc: prometheus.NewDesc(
 					prometheus.BuildFQName(namespace, diskSubsystem, "io_time_weighted_seconds_total"),
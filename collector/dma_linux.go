@@ -0,0 +1,95 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodma
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type dmaCollector struct {
+	bytesTransferred *prometheus.Desc
+	memcpyCount      *prometheus.Desc
+	inUse            *prometheus.Desc
+	logger           *slog.Logger
+}
+
+func init() {
+	registerCollector("dma", defaultDisabled, NewDMACollector)
+}
+
+// NewDMACollector returns a new Collector exposing DMA engine channel statistics.
+func NewDMACollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "dma"
+
+	return &dmaCollector{
+		bytesTransferred: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "bytes_transferred_total"),
+			"Number of bytes transferred by the DMA channel.",
+			[]string{"channel"}, nil,
+		),
+		memcpyCount: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "memcpy_operations_total"),
+			"Number of memcpy operations submitted to the DMA channel.",
+			[]string{"channel"}, nil,
+		),
+		inUse: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "channel_in_use"),
+			"Number of clients using the DMA channel.",
+			[]string{"channel"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *dmaCollector) Update(ch chan<- prometheus.Metric) error {
+	channels, err := filepath.Glob(sysFilePath("class/dma/dma*chan*"))
+	if err != nil {
+		return err
+	}
+	if len(channels) == 0 {
+		c.logger.Debug("no DMA channels found")
+		return ErrNoData
+	}
+
+	for _, dir := range channels {
+		channel := filepath.Base(dir)
+		for _, m := range []struct {
+			file      string
+			desc      *prometheus.Desc
+			valueType prometheus.ValueType
+		}{
+			{"bytes_transferred", c.bytesTransferred, prometheus.CounterValue},
+			{"memcpy_count", c.memcpyCount, prometheus.CounterValue},
+			{"in_use", c.inUse, prometheus.GaugeValue},
+		} {
+			value, err := readUintFromFile(filepath.Join(dir, m.file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, float64(value), channel)
+		}
+	}
+
+	return nil
+}
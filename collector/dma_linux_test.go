@@ -0,0 +1,68 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodma
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testDMACollector struct {
+	tc Collector
+}
+
+func (c testDMACollector) Collect(ch chan<- prometheus.Metric) {
+	c.tc.Update(ch)
+}
+
+func (c testDMACollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestDMAStats(t *testing.T) {
+	testcase := `# HELP node_dma_bytes_transferred_total Number of bytes transferred by the DMA channel.
+	# TYPE node_dma_bytes_transferred_total counter
+	node_dma_bytes_transferred_total{channel="dma0chan0"} 1.048576e+06
+	node_dma_bytes_transferred_total{channel="dma0chan1"} 0
+	# HELP node_dma_channel_in_use Number of clients using the DMA channel.
+	# TYPE node_dma_channel_in_use gauge
+	node_dma_channel_in_use{channel="dma0chan0"} 1
+	node_dma_channel_in_use{channel="dma0chan1"} 0
+	# HELP node_dma_memcpy_operations_total Number of memcpy operations submitted to the DMA channel.
+	# TYPE node_dma_memcpy_operations_total counter
+	node_dma_memcpy_operations_total{channel="dma0chan0"} 256
+	node_dma_memcpy_operations_total{channel="dma0chan1"} 0
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewDMACollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testDMACollector{tc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
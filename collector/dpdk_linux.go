@@ -0,0 +1,179 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodpdk
+
+package collector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dpdkTelemetrySocket = kingpin.Flag("collector.dpdk.telemetry-socket", "Path to the DPDK telemetry v2 socket.").Default("/var/run/dpdk/rte/dpdk_telemetry.v2").String()
+)
+
+const dpdkTelemetryTimeout = 5 * time.Second
+
+type dpdkCollector struct {
+	lcoreCount       *prometheus.Desc
+	mempoolAvailable *prometheus.Desc
+	mempoolInUse     *prometheus.Desc
+	logger           *slog.Logger
+}
+
+func init() {
+	registerCollector("dpdk", defaultDisabled, NewDPDKCollector)
+}
+
+// NewDPDKCollector returns a new Collector exposing DPDK lcore and mempool
+// statistics from the DPDK telemetry socket.
+func NewDPDKCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "dpdk"
+
+	return &dpdkCollector{
+		lcoreCount: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "lcores"),
+			"Number of logical cores used by the DPDK application.",
+			nil, nil,
+		),
+		mempoolAvailable: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "mempool_available_entries"),
+			"Number of available entries in the DPDK mempool.",
+			[]string{"pool"}, nil,
+		),
+		mempoolInUse: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "mempool_in_use_entries"),
+			"Number of entries in use in the DPDK mempool.",
+			[]string{"pool"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *dpdkCollector) Update(ch chan<- prometheus.Metric) error {
+	client, err := newDPDKTelemetryClient(*dpdkTelemetrySocket)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("DPDK telemetry socket not found", "path", *dpdkTelemetrySocket)
+			return ErrNoData
+		}
+		return err
+	}
+	defer client.Close()
+
+	var lcores []int
+	if err := client.query("/eal/lcore_list", &lcores); err != nil {
+		return err
+	}
+	ch <- prometheus.MustNewConstMetric(c.lcoreCount, prometheus.GaugeValue, float64(len(lcores)))
+
+	var pools []string
+	if err := client.query("/mempool/list", &pools); err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		var info struct {
+			AvailCount *uint64 `json:"avail_count"`
+			InUseCount *uint64 `json:"in_use_count"`
+		}
+		if err := client.query("/mempool/info,"+pool, &info); err != nil {
+			c.logger.Debug("failed to query DPDK mempool info", "pool", pool, "err", err)
+			continue
+		}
+		if info.AvailCount != nil {
+			ch <- prometheus.MustNewConstMetric(c.mempoolAvailable, prometheus.GaugeValue, float64(*info.AvailCount), pool)
+		}
+		if info.InUseCount != nil {
+			ch <- prometheus.MustNewConstMetric(c.mempoolInUse, prometheus.GaugeValue, float64(*info.InUseCount), pool)
+		}
+	}
+
+	return nil
+}
+
+type dpdkTelemetryClient struct {
+	conn   net.Conn
+	bufLen int
+}
+
+// newDPDKTelemetryClient connects to the telemetry socket and consumes the
+// initial message the server sends on every new connection.
+func newDPDKTelemetryClient(path string) (*dpdkTelemetryClient, error) {
+	conn, err := net.DialTimeout("unixpacket", path, dpdkTelemetryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(dpdkTelemetryTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	buf := make([]byte, 1024)
+	n, err := conn.Read(buf)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read DPDK telemetry greeting: %w", err)
+	}
+	var greeting struct {
+		MaxOutputLen int `json:"max_output_len"`
+	}
+	if err := json.Unmarshal(buf[:n], &greeting); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to parse DPDK telemetry greeting: %w", err)
+	}
+	if greeting.MaxOutputLen <= 0 {
+		greeting.MaxOutputLen = 16384
+	}
+
+	return &dpdkTelemetryClient{conn: conn, bufLen: greeting.MaxOutputLen}, nil
+}
+
+// query sends a telemetry command and decodes the value of the response,
+// which is returned as a JSON object keyed by the command name.
+func (c *dpdkTelemetryClient) query(cmd string, v any) error {
+	if _, err := c.conn.Write([]byte(cmd)); err != nil {
+		return fmt.Errorf("failed to send DPDK telemetry command %q: %w", cmd, err)
+	}
+
+	buf := make([]byte, c.bufLen)
+	n, err := c.conn.Read(buf)
+	if err != nil {
+		return fmt.Errorf("failed to read DPDK telemetry response for %q: %w", cmd, err)
+	}
+
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(buf[:n], &resp); err != nil {
+		return fmt.Errorf("failed to parse DPDK telemetry response for %q: %w", cmd, err)
+	}
+	if len(resp) != 1 {
+		return fmt.Errorf("unexpected DPDK telemetry response for %q", cmd)
+	}
+	for _, raw := range resp {
+		return json.Unmarshal(raw, v)
+	}
+	return nil
+}
+
+func (c *dpdkTelemetryClient) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodpdk
+
+package collector
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testDPDKCollector struct {
+	dc Collector
+}
+
+func (c testDPDKCollector) Collect(ch chan<- prometheus.Metric) {
+	c.dc.Update(ch)
+}
+
+func (c testDPDKCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// serveDPDKTelemetry answers telemetry commands like a DPDK application, an
+// unknown command gets a null value.
+func serveDPDKTelemetry(t *testing.T, l net.Listener, responses map[string]any) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func(conn net.Conn) {
+			defer conn.Close()
+			if _, err := conn.Write([]byte(`{"version":"DPDK 23.11.0","pid":4242,"max_output_len":16384}`)); err != nil {
+				return
+			}
+			buf := make([]byte, 1024)
+			for {
+				n, err := conn.Read(buf)
+				if err != nil {
+					return
+				}
+				cmd := string(buf[:n])
+				resp, err := json.Marshal(map[string]any{strings.SplitN(cmd, ",", 2)[0]: responses[cmd]})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := conn.Write(resp); err != nil {
+					return
+				}
+			}
+		}(conn)
+	}
+}
+
+func TestDPDKStats(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "dpdk_telemetry.v2")
+	l, err := net.Listen("unixpacket", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go serveDPDKTelemetry(t, l, map[string]any{
+		"/eal/lcore_list": []int{1, 2, 3},
+		"/mempool/list":   []string{"mb_pool_0", "mb_pool_1"},
+		"/mempool/info,mb_pool_0": map[string]any{
+			"name":         "mb_pool_0",
+			"size":         180224,
+			"avail_count":  177911,
+			"in_use_count": 2313,
+		},
+	})
+
+	// mb_pool_1 was freed after listing the pools.
+	testcase := `# HELP node_dpdk_lcores Number of logical cores used by the DPDK application.
+	# TYPE node_dpdk_lcores gauge
+	node_dpdk_lcores 3
+	# HELP node_dpdk_mempool_available_entries Number of available entries in the DPDK mempool.
+	# TYPE node_dpdk_mempool_available_entries gauge
+	node_dpdk_mempool_available_entries{pool="mb_pool_0"} 177911
+	# HELP node_dpdk_mempool_in_use_entries Number of entries in use in the DPDK mempool.
+	# TYPE node_dpdk_mempool_in_use_entries gauge
+	node_dpdk_mempool_in_use_entries{pool="mb_pool_0"} 2313
+	`
+	*dpdkTelemetrySocket = socket
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewDPDKCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testDPDKCollector{dc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
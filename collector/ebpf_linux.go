@@ -0,0 +1,223 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noebpf
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ebpfMapTypes maps enum bpf_map_type from include/uapi/linux/bpf.h to the
+// names used by bpftool.
+var ebpfMapTypes = []string{
+	"unspec",
+	"hash",
+	"array",
+	"prog_array",
+	"perf_event_array",
+	"percpu_hash",
+	"percpu_array",
+	"stack_trace",
+	"cgroup_array",
+	"lru_hash",
+	"lru_percpu_hash",
+	"lpm_trie",
+	"array_of_maps",
+	"hash_of_maps",
+	"devmap",
+	"sockmap",
+	"cpumap",
+	"xskmap",
+	"sockhash",
+	"cgroup_storage",
+	"reuseport_sockarray",
+	"percpu_cgroup_storage",
+	"queue",
+	"stack",
+	"sk_storage",
+	"devmap_hash",
+	"struct_ops",
+	"ringbuf",
+	"inode_storage",
+	"task_storage",
+	"bloom_filter",
+	"user_ringbuf",
+	"cgrp_storage",
+	"arena",
+}
+
+// ebpfMap holds the fdinfo fields of an eBPF map.
+type ebpfMap struct {
+	mapType string
+	memlock uint64
+}
+
+type ebpfCollector struct {
+	mapMemory *prometheus.Desc
+	maps      *prometheus.Desc
+	logger    *slog.Logger
+}
+
+func init() {
+	registerCollector("ebpf", defaultDisabled, NewEbpfCollector)
+}
+
+// NewEbpfCollector returns a new Collector exposing eBPF map memory usage.
+//
+// Events lost by perf or ring buffers can't be exposed: the fdinfo of perf
+// events and ringbuf maps has no such counter, perf buffers report losses
+// in-band as PERF_RECORD_LOST records to the reader and ring buffer drops
+// are only visible to the BPF program as a failed reserve.
+func NewEbpfCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "ebpf"
+
+	return &ebpfCollector{
+		mapMemory: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "map_memory_bytes"),
+			"Memory charged to eBPF maps as reported by the memlock field of their fdinfo.",
+			[]string{"map_type"}, nil,
+		),
+		maps: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "maps"),
+			"Number of eBPF maps held open by processes.",
+			[]string{"map_type"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ebpfCollector) Update(ch chan<- prometheus.Metric) error {
+	pids, err := filepath.Glob(procFilePath("[0-9]*"))
+	if err != nil {
+		return err
+	}
+
+	// The same map can be referenced by many file descriptors, so only
+	// count every map ID once.
+	maps := make(map[uint64]ebpfMap)
+	denied := false
+	for _, pid := range pids {
+		// The fdinfo of other users' processes is only readable by root.
+		fds, err := os.ReadDir(filepath.Join(pid, "fdinfo"))
+		if err != nil {
+			if errors.Is(err, os.ErrPermission) {
+				denied = true
+			}
+			// Processes may disappear while we scan.
+			c.logger.Debug("failed to list fdinfo", "pid", filepath.Base(pid), "err", err)
+			continue
+		}
+		for _, fd := range fds {
+			path := filepath.Join(pid, "fdinfo", fd.Name())
+			id, m, ok, err := parseEbpfMapFdinfo(path)
+			if err != nil {
+				if errors.Is(err, os.ErrPermission) {
+					denied = true
+				}
+				c.logger.Debug("failed to read fdinfo", "path", path, "err", err)
+				continue
+			}
+			if ok {
+				maps[id] = m
+			}
+		}
+	}
+	if denied {
+		c.logger.Debug("not permitted to read the fdinfo of all processes, node_exporter must run as root")
+	}
+	if len(maps) == 0 {
+		return ErrNoData
+	}
+
+	memory := make(map[string]uint64)
+	count := make(map[string]uint64)
+	for _, m := range maps {
+		memory[m.mapType] += m.memlock
+		count[m.mapType]++
+	}
+
+	for mapType, bytes := range memory {
+		ch <- prometheus.MustNewConstMetric(c.mapMemory, prometheus.GaugeValue, float64(bytes), mapType)
+		ch <- prometheus.MustNewConstMetric(c.maps, prometheus.GaugeValue, float64(count[mapType]), mapType)
+	}
+
+	return nil
+}
+
+// parseEbpfMapFdinfo parses a /proc/<pid>/fdinfo/<fd> file and reports
+// whether it describes an eBPF map.
+func parseEbpfMapFdinfo(path string) (uint64, ebpfMap, bool, error) {
+	var (
+		m     ebpfMap
+		id    uint64
+		isMap bool
+	)
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, m, false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		var dst *uint64
+		switch key {
+		case "map_id":
+			dst = &id
+			isMap = true
+		case "map_type":
+			m.mapType = ebpfMapTypeName(value)
+			continue
+		case "memlock":
+			dst = &m.memlock
+		default:
+			continue
+		}
+
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, m, false, fmt.Errorf("invalid %s %q: %w", key, value, err)
+		}
+		*dst = v
+	}
+
+	return id, m, isMap, scanner.Err()
+}
+
+// ebpfMapTypeName returns the name of a numeric map type, types unknown to
+// node_exporter are kept as the number.
+func ebpfMapTypeName(value string) string {
+	t, err := strconv.Atoi(value)
+	if err != nil || t < 0 || t >= len(ebpfMapTypes) {
+		return value
+	}
+	return ebpfMapTypes[t]
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noebpf
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testEbpfCollector struct {
+	ec Collector
+}
+
+func (c testEbpfCollector) Collect(ch chan<- prometheus.Metric) {
+	c.ec.Update(ch)
+}
+
+func (c testEbpfCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestEbpfMapTypeName(t *testing.T) {
+	for value, want := range map[string]string{
+		"1":   "hash",
+		"27":  "ringbuf",
+		"999": "999",
+	} {
+		if got := ebpfMapTypeName(value); got != want {
+			t.Errorf("map type %s: want %q, got %q", value, want, got)
+		}
+	}
+}
+
+func TestEbpfMapMemory(t *testing.T) {
+	testcase := `# HELP node_ebpf_map_memory_bytes Memory charged to eBPF maps as reported by the memlock field of their fdinfo.
+	# TYPE node_ebpf_map_memory_bytes gauge
+	node_ebpf_map_memory_bytes{map_type="array"} 4096
+	node_ebpf_map_memory_bytes{map_type="hash"} 90112
+	node_ebpf_map_memory_bytes{map_type="ringbuf"} 270336
+	# HELP node_ebpf_maps Number of eBPF maps held open by processes.
+	# TYPE node_ebpf_maps gauge
+	node_ebpf_maps{map_type="array"} 1
+	node_ebpf_maps{map_type="hash"} 1
+	node_ebpf_maps{map_type="ringbuf"} 1
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewEbpfCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testEbpfCollector{ec: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
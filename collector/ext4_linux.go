@@ -0,0 +1,181 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noext4
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ext4JournalInfo holds the statistics of /proc/fs/jbd2/<device>-<inode>/info.
+type ext4JournalInfo struct {
+	transactions         uint64
+	maxTransactionBlocks uint64
+	blocksPerTransaction uint64
+}
+
+type ext4Collector struct {
+	lifetimeWrite       *prometheus.Desc
+	sessionWrite        *prometheus.Desc
+	journalTransactions *prometheus.Desc
+	journalMaxBlocks    *prometheus.Desc
+	journalBlocksAvg    *prometheus.Desc
+	logger              *slog.Logger
+}
+
+func init() {
+	registerCollector("ext4", defaultDisabled, NewExt4Collector)
+}
+
+// NewExt4Collector returns a new Collector exposing ext4 write and journal statistics.
+func NewExt4Collector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "ext4"
+
+	return &ext4Collector{
+		lifetimeWrite: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "lifetime_write_bytes_total"),
+			"Number of bytes written to the filesystem over its lifetime.",
+			[]string{"device"}, nil,
+		),
+		sessionWrite: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "session_write_bytes_total"),
+			"Number of bytes written to the filesystem since it was mounted.",
+			[]string{"device"}, nil,
+		),
+		journalTransactions: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "journal_transactions_total"),
+			"Number of journal transactions committed since the filesystem was mounted.",
+			[]string{"device"}, nil,
+		),
+		journalMaxBlocks: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "journal_max_transaction_blocks"),
+			"Maximum number of journal blocks of a transaction.",
+			[]string{"device"}, nil,
+		),
+		journalBlocksAvg: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "journal_transaction_blocks_average"),
+			"Average number of blocks per journal transaction since the filesystem was mounted.",
+			[]string{"device"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ext4Collector) Update(ch chan<- prometheus.Metric) error {
+	devices, err := filepath.Glob(sysFilePath("fs/ext4/*/lifetime_write_kbytes"))
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		c.logger.Debug("no mounted ext4 filesystems")
+		return ErrNoData
+	}
+
+	for _, path := range devices {
+		dir := filepath.Dir(path)
+		device := filepath.Base(dir)
+		for _, f := range []struct {
+			file string
+			desc *prometheus.Desc
+		}{
+			{"lifetime_write_kbytes", c.lifetimeWrite},
+			{"session_write_kbytes", c.sessionWrite},
+		} {
+			kbytes, err := readUintFromFile(filepath.Join(dir, f.file))
+			if err != nil {
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(f.desc, prometheus.CounterValue, float64(kbytes*1024), device)
+		}
+	}
+
+	// Journals are named <device>-<journal inode>, e.g. sda1-8.
+	journals, err := filepath.Glob(procFilePath("fs/jbd2/*/info"))
+	if err != nil {
+		return err
+	}
+	for _, path := range journals {
+		name := filepath.Base(filepath.Dir(path))
+		device := name[:max(strings.LastIndex(name, "-"), 0)]
+		if _, err := os.Stat(sysFilePath(filepath.Join("fs/ext4", device))); err != nil {
+			// Not an ext4 journal, e.g. ocfs2.
+			continue
+		}
+
+		info, err := readExt4JournalInfo(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		ch <- prometheus.MustNewConstMetric(c.journalTransactions, prometheus.CounterValue, float64(info.transactions), device)
+		ch <- prometheus.MustNewConstMetric(c.journalMaxBlocks, prometheus.GaugeValue, float64(info.maxTransactionBlocks), device)
+		ch <- prometheus.MustNewConstMetric(c.journalBlocksAvg, prometheus.GaugeValue, float64(info.blocksPerTransaction), device)
+	}
+
+	return nil
+}
+
+func readExt4JournalInfo(path string) (ext4JournalInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ext4JournalInfo{}, err
+	}
+	defer file.Close()
+
+	return parseExt4JournalInfo(file)
+}
+
+// parseExt4JournalInfo parses a jbd2 info file, which starts with
+// "2345 transactions (2340 requested), each up to 8192 blocks" followed by
+// averages such as "  5 blocks per transaction".
+func parseExt4JournalInfo(r io.Reader) (ext4JournalInfo, error) {
+	var info ext4JournalInfo
+
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return info, errors.New("empty journal info")
+	}
+	var requested uint64
+	if _, err := fmt.Sscanf(scanner.Text(), "%d transactions (%d requested), each up to %d blocks",
+		&info.transactions, &requested, &info.maxTransactionBlocks); err != nil {
+		return info, fmt.Errorf("unexpected line %q: %w", scanner.Text(), err)
+	}
+
+	for scanner.Scan() {
+		// Skip "6 logged blocks per transaction".
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 4 && strings.Join(fields[1:], " ") == "blocks per transaction" {
+			v, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				return info, fmt.Errorf("unexpected line %q: %w", scanner.Text(), err)
+			}
+			info.blocksPerTransaction = v
+		}
+	}
+
+	return info, scanner.Err()
+}
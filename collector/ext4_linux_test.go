@@ -0,0 +1,55 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noext4
+
+package collector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseExt4JournalInfo(t *testing.T) {
+	info, err := parseExt4JournalInfo(strings.NewReader(`1297 transactions (1279 requested), each up to 8192 blocks
+average: 
+  0ms waiting for transaction
+  0ms request delay
+  4964ms running transaction
+  0ms transaction was being locked
+  0ms flushing data (in ordered mode)
+  16ms logging transaction
+  17256us average transaction commit time
+  62 handles per transaction
+  14 blocks per transaction
+  15 logged blocks per transaction
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ext4JournalInfo{
+		transactions:         1297,
+		maxTransactionBlocks: 8192,
+		blocksPerTransaction: 14,
+	}
+	if info != want {
+		t.Fatalf("want %+v, got %+v", want, info)
+	}
+
+	if _, err := parseExt4JournalInfo(strings.NewReader("")); err == nil {
+		t.Fatal("expected error for empty journal info")
+	}
+	if _, err := parseExt4JournalInfo(strings.NewReader("garbage\n")); err == nil {
+		t.Fatal("expected error for malformed journal info")
+	}
+}
@@ -14,6 +14,7 @@
 package collector
 
 import (
+	"bufio"
 	"os"
 	"regexp"
 	"strconv"
@@ -48,3 +49,30 @@ var metricNameRegex = regexp.MustCompile(`_*[^0-9A-Za-z_]+_*`)
 func SanitizeMetricName(metricName string) string {
 	return metricNameRegex.ReplaceAllString(metricName, "_")
 }
+
+// readFlatKeyedFile parses a file with one "key value" pair per line, like
+// the flat keyed cgroup v2 files cpu.stat or memory.events. Lines that
+// don't have exactly two fields are skipped.
+func readFlatKeyedFile(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stats := make(map[string]uint64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		stats[fields[0]] = v
+	}
+
+	return stats, scanner.Err()
+}
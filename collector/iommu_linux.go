@@ -0,0 +1,84 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noiommu
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type iommuCollector struct {
+	groupInfo    *prometheus.Desc
+	groupDevices *prometheus.Desc
+	logger       *slog.Logger
+}
+
+func init() {
+	registerCollector("iommu", defaultDisabled, NewIOMMUCollector)
+}
+
+// NewIOMMUCollector returns a new Collector exposing the IOMMU group of devices.
+func NewIOMMUCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "iommu"
+
+	return &iommuCollector{
+		groupInfo: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "device_group_info"),
+			"IOMMU group of a device, from /sys/kernel/iommu_groups.",
+			[]string{"slot", "iommu_group"}, nil,
+		),
+		groupDevices: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "group_devices"),
+			"Number of devices in the IOMMU group.",
+			[]string{"iommu_group"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *iommuCollector) Update(ch chan<- prometheus.Metric) error {
+	groups, err := os.ReadDir(sysFilePath("kernel/iommu_groups"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("no IOMMU groups, is the IOMMU enabled?")
+			return ErrNoData
+		}
+		return err
+	}
+	if len(groups) == 0 {
+		c.logger.Debug("no IOMMU groups, is the IOMMU enabled?")
+		return ErrNoData
+	}
+
+	for _, group := range groups {
+		// The devices directory holds a symlink to every device of the group,
+		// named after the device, e.g. 0000:01:00.0.
+		devices, err := os.ReadDir(filepath.Join(sysFilePath("kernel/iommu_groups"), group.Name(), "devices"))
+		if err != nil {
+			return err
+		}
+		for _, device := range devices {
+			ch <- prometheus.MustNewConstMetric(c.groupInfo, prometheus.GaugeValue, 1, device.Name(), group.Name())
+		}
+		ch <- prometheus.MustNewConstMetric(c.groupDevices, prometheus.GaugeValue, float64(len(devices)), group.Name())
+	}
+
+	return nil
+}
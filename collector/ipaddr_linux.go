@@ -0,0 +1,114 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noipaddr
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/jsimonetti/rtnetlink/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+// ipAddrKey groups addresses by their labels.
+type ipAddrKey struct {
+	family    string
+	prefixLen string
+	scope     string
+}
+
+type ipAddrCollector struct {
+	addresses *prometheus.Desc
+	logger    *slog.Logger
+}
+
+func init() {
+	registerCollector("ipaddr", defaultDisabled, NewIPAddrCollector)
+}
+
+// NewIPAddrCollector returns a new Collector exposing the number of
+// configured IP addresses by prefix length and scope.
+func NewIPAddrCollector(logger *slog.Logger) (Collector, error) {
+	return &ipAddrCollector{
+		addresses: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "network", "addresses"),
+			"Number of IP addresses configured on the interfaces by address family, prefix length and scope.",
+			[]string{"family", "prefix_len", "scope"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ipAddrCollector) Update(ch chan<- prometheus.Metric) error {
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return fmt.Errorf("couldn't connect rtnetlink: %w", err)
+	}
+	defer conn.Close()
+
+	// RTM_GETADDR dumps the addresses of both families.
+	addrs, err := conn.Address.List()
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses: %w", err)
+	}
+
+	for key, count := range countIPAddresses(addrs) {
+		ch <- prometheus.MustNewConstMetric(c.addresses, prometheus.GaugeValue, float64(count), key.family, key.prefixLen, key.scope)
+	}
+
+	return nil
+}
+
+func countIPAddresses(addrs []rtnetlink.AddressMessage) map[ipAddrKey]int {
+	counts := map[ipAddrKey]int{}
+	for _, addr := range addrs {
+		var family string
+		switch addr.Family {
+		case unix.AF_INET:
+			family = "ipv4"
+		case unix.AF_INET6:
+			family = "ipv6"
+		default:
+			continue
+		}
+		counts[ipAddrKey{
+			family:    family,
+			prefixLen: strconv.Itoa(int(addr.PrefixLength)),
+			scope:     ipAddrScopeToString(addr.Scope),
+		}]++
+	}
+	return counts
+}
+
+// ipAddrScopeToString converts the rtm_scope values of include/uapi/linux/rtnetlink.h.
+func ipAddrScopeToString(scope uint8) string {
+	switch scope {
+	case unix.RT_SCOPE_UNIVERSE:
+		return "global"
+	case unix.RT_SCOPE_SITE:
+		return "site"
+	case unix.RT_SCOPE_LINK:
+		return "link"
+	case unix.RT_SCOPE_HOST:
+		return "host"
+	case unix.RT_SCOPE_NOWHERE:
+		return "nowhere"
+	default:
+		return strconv.Itoa(int(scope))
+	}
+}
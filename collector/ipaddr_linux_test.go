@@ -0,0 +1,46 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noipaddr
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jsimonetti/rtnetlink/v2"
+	"golang.org/x/sys/unix"
+)
+
+func TestCountIPAddresses(t *testing.T) {
+	addrs := []rtnetlink.AddressMessage{
+		{Family: unix.AF_INET, PrefixLength: 8, Scope: unix.RT_SCOPE_HOST},
+		{Family: unix.AF_INET, PrefixLength: 24, Scope: unix.RT_SCOPE_UNIVERSE},
+		{Family: unix.AF_INET, PrefixLength: 32, Scope: unix.RT_SCOPE_UNIVERSE},
+		{Family: unix.AF_INET, PrefixLength: 32, Scope: unix.RT_SCOPE_UNIVERSE},
+		{Family: unix.AF_INET6, PrefixLength: 64, Scope: unix.RT_SCOPE_LINK},
+		{Family: unix.AF_INET6, PrefixLength: 128, Scope: unix.RT_SCOPE_HOST},
+	}
+
+	want := map[ipAddrKey]int{
+		{"ipv4", "8", "host"}:    1,
+		{"ipv4", "24", "global"}: 1,
+		{"ipv4", "32", "global"}: 2,
+		{"ipv6", "64", "link"}:   1,
+		{"ipv6", "128", "host"}:  1,
+	}
+	if got := countIPAddresses(addrs); !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noipforward
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var ipForwardPerDevice = kingpin.Flag("collector.ipforward.per-device", "Expose the forwarding state of every network device.").Default("false").Bool()
+
+type ipForwardCollector struct {
+	enabled *prometheus.Desc
+	logger  *slog.Logger
+}
+
+func init() {
+	registerCollector("ipforward", defaultDisabled, NewIPForwardCollector)
+}
+
+// NewIPForwardCollector returns a new Collector exposing the IP forwarding state.
+func NewIPForwardCollector(logger *slog.Logger) (Collector, error) {
+	return &ipForwardCollector{
+		enabled: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "network", "ip_forwarding_enabled"),
+			"Whether IP forwarding is enabled, device=\"all\" is the global setting.",
+			[]string{"family", "device"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ipForwardCollector) Update(ch chan<- prometheus.Metric) error {
+	for _, family := range []string{"ipv4", "ipv6"} {
+		devices := []string{"all"}
+		if *ipForwardPerDevice {
+			dirs, err := os.ReadDir(procFilePath(filepath.Join("sys/net", family, "conf")))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			for _, d := range dirs {
+				if name := d.Name(); name != "all" && name != "default" {
+					devices = append(devices, name)
+				}
+			}
+		}
+
+		for _, device := range devices {
+			path := filepath.Join("sys/net", family, "conf", device, "forwarding")
+			if family == "ipv4" && device == "all" {
+				// Same as net.ipv4.conf.all.forwarding.
+				path = "sys/net/ipv4/ip_forward"
+			}
+			value, err := readUintFromFile(procFilePath(path))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					// IPv6 disabled or the device is gone.
+					c.logger.Debug("forwarding state not available", "family", family, "device", device)
+					continue
+				}
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(c.enabled, prometheus.GaugeValue, float64(value), family, device)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nokerberos
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MIT krb5 KEYRING credential caches are keyrings named krb_ccache_<random>.
+const kerberosCCachePrefix = "krb_ccache_"
+
+// kerberosCCache is a credential cache keyring listed in /proc/keys.
+type kerberosCCache struct {
+	name string
+	uid  string
+	// remaining is the number of seconds until the keyring expires, or -1
+	// if it doesn't expire.
+	remaining float64
+}
+
+type kerberosCollector struct {
+	ccaches   *prometheus.Desc
+	remaining *prometheus.Desc
+	logger    *slog.Logger
+}
+
+func init() {
+	registerCollector("kerberos", defaultDisabled, NewKerberosCollector)
+}
+
+// NewKerberosCollector returns a new Collector exposing the Kerberos
+// credential caches stored in the kernel keyring.
+func NewKerberosCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "kerberos"
+
+	return &kerberosCollector{
+		ccaches: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "ccaches"),
+			"Number of Kerberos credential caches in the kernel keyring. Only caches the exporter may view are counted, usually those of its own user.",
+			[]string{"uid"}, nil,
+		),
+		remaining: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "ccache_remaining_seconds"),
+			"Seconds until the credentials of the Kerberos credential cache expire. Only caches the exporter may view are exposed.",
+			[]string{"uid", "ccache"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *kerberosCollector) Update(ch chan<- prometheus.Metric) error {
+	// Only keys the exporter may view are listed. Credential cache keyrings
+	// grant no permissions to other users and root has no override, so
+	// usually only the exporter's own caches are found.
+	file, err := os.Open(procFilePath("keys"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("kernel built without key retention support")
+			return ErrNoData
+		}
+		return err
+	}
+	defer file.Close()
+
+	ccaches, err := parseKerberosCCaches(file)
+	if err != nil {
+		return fmt.Errorf("failed to parse /proc/keys: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, cc := range ccaches {
+		counts[cc.uid]++
+		if cc.remaining >= 0 {
+			ch <- prometheus.MustNewConstMetric(c.remaining, prometheus.GaugeValue, cc.remaining, cc.uid, cc.name)
+		}
+	}
+	for uid, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.ccaches, prometheus.GaugeValue, float64(count), uid)
+	}
+
+	return nil
+}
+
+// parseKerberosCCaches returns the credential cache keyrings of /proc/keys,
+// which has lines like
+// "2c8b1e37 I--Q---     2   9h 3f010000  1000  1000 keyring   krb_ccache_Xk3tQ9a: 2".
+func parseKerberosCCaches(r io.Reader) ([]kerberosCCache, error) {
+	var ccaches []kerberosCCache
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("unexpected line %q", scanner.Text())
+		}
+		name := strings.TrimSuffix(fields[8], ":")
+		if fields[7] != "keyring" || !strings.HasPrefix(name, kerberosCCachePrefix) {
+			continue
+		}
+		remaining, err := parseKeyTimeout(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout in line %q: %w", scanner.Text(), err)
+		}
+		ccaches = append(ccaches, kerberosCCache{
+			name:      name,
+			uid:       fields[5],
+			remaining: remaining,
+		})
+	}
+
+	return ccaches, scanner.Err()
+}
+
+// parseKeyTimeout parses the timeout of a key as printed by the kernel:
+// "perm", "expd" or the time left rounded down to a single unit, e.g. "9h".
+func parseKeyTimeout(s string) (float64, error) {
+	switch s {
+	case "perm":
+		return -1, nil
+	case "expd":
+		return 0, nil
+	}
+	if len(s) < 2 {
+		return 0, fmt.Errorf("unexpected timeout %q", s)
+	}
+
+	value, err := strconv.ParseUint(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	units := map[byte]uint64{'s': 1, 'm': 60, 'h': 60 * 60, 'd': 24 * 60 * 60, 'w': 7 * 24 * 60 * 60}
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, fmt.Errorf("unexpected timeout unit %q", s)
+	}
+	return float64(value * unit), nil
+}
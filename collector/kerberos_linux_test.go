@@ -0,0 +1,47 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nokerberos
+
+package collector
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseKerberosCCaches(t *testing.T) {
+	const keys = `0361b01e I------     1 perm 1f0b0000     0     0 keyring   .builtin_trusted_keys: empty
+20e1db45 I--Q---     2 perm 1f3f0000     0 65534 keyring   _uid.0: empty
+13a0d2f1 I--Q---     1 perm 3f010000  1000  1000 keyring   _krb_persistent: 1
+2c8b1e37 I--Q---     2   9h 3f010000  1000  1000 keyring   krb_ccache_Xk3tQ9a: 2
+1f5e8a02 I--Q---     1   9h 3f010000  1000  1000 user      __krb5_princ__: 23
+3b9a7c40 I--Q---     1 perm 3f010000  1001  1001 keyring   krb_ccache_pR7mW2c: empty
+0a4d6e19 I--Q---     1   3d 3f010000     0     0 keyring   krb_ccache_nfs: 1
+`
+
+	ccaches, err := parseKerberosCCaches(strings.NewReader(keys))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []kerberosCCache{
+		{name: "krb_ccache_Xk3tQ9a", uid: "1000", remaining: 32400},
+		{name: "krb_ccache_pR7mW2c", uid: "1001", remaining: -1},
+		{name: "krb_ccache_nfs", uid: "0", remaining: 259200},
+	}
+	if !reflect.DeepEqual(ccaches, want) {
+		t.Fatalf("want %+v, got %+v", want, ccaches)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nokhugepaged
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// khugepagedFiles describes the files below
+// /sys/kernel/mm/transparent_hugepage/khugepaged.
+var khugepagedFiles = []struct {
+	file      string
+	name      string
+	help      string
+	valueType prometheus.ValueType
+	scale     float64
+}{
+	{"pages_collapsed", "pages_collapsed_total", "Number of pages collapsed into huge pages by khugepaged.", prometheus.CounterValue, 1},
+	{"full_scans", "full_scans_total", "Number of full scans of all memory by khugepaged.", prometheus.CounterValue, 1},
+	{"pages_to_scan", "pages_to_scan", "Number of pages khugepaged scans in each pass.", prometheus.GaugeValue, 1},
+	{"scan_sleep_millisecs", "scan_sleep_seconds", "Time khugepaged sleeps between passes.", prometheus.GaugeValue, 0.001},
+	{"alloc_sleep_millisecs", "alloc_sleep_seconds", "Time khugepaged sleeps after a huge page allocation failure.", prometheus.GaugeValue, 0.001},
+}
+
+type khugepagedCollector struct {
+	descs  []*prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("khugepaged", defaultDisabled, NewKhugepagedCollector)
+}
+
+// NewKhugepagedCollector returns a new Collector exposing khugepaged statistics.
+func NewKhugepagedCollector(logger *slog.Logger) (Collector, error) {
+	descs := make([]*prometheus.Desc, len(khugepagedFiles))
+	for i, f := range khugepagedFiles {
+		descs[i] = prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "khugepaged", f.name),
+			f.help, nil, nil,
+		)
+	}
+	return &khugepagedCollector{descs: descs, logger: logger}, nil
+}
+
+func (c *khugepagedCollector) Update(ch chan<- prometheus.Metric) error {
+	dir := sysFilePath("kernel/mm/transparent_hugepage/khugepaged")
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		c.logger.Debug("transparent hugepages not supported", "dir", dir)
+		return ErrNoData
+	}
+
+	for i, f := range khugepagedFiles {
+		val, err := readUintFromFile(filepath.Join(dir, f.file))
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(c.descs[i], f.valueType, float64(val)*f.scale)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noktls
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+type ktlsCollector struct {
+	fs              procfs.FS
+	currentSessions *prometheus.Desc
+	sessions        *prometheus.Desc
+	decryptErrors   *prometheus.Desc
+	rxDeviceResync  *prometheus.Desc
+	decryptRetries  *prometheus.Desc
+	rxNoPadViolated *prometheus.Desc
+	logger          *slog.Logger
+}
+
+func init() {
+	registerCollector("ktls", defaultDisabled, NewKTLSCollector)
+}
+
+// NewKTLSCollector returns a new Collector exposing kernel TLS statistics.
+func NewKTLSCollector(logger *slog.Logger) (Collector, error) {
+	fs, err := procfs.NewFS(*procPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open procfs: %w", err)
+	}
+
+	const subsystem = "ktls"
+	return &ktlsCollector{
+		fs: fs,
+		currentSessions: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "current_sessions"),
+			"Number of kTLS sessions currently installed, by direction and whether the host (sw) or NIC (device) handles cryptography.",
+			[]string{"direction", "mode"}, nil,
+		),
+		sessions: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "sessions_total"),
+			"Number of kTLS sessions opened, by direction and whether the host (sw) or NIC (device) handles cryptography.",
+			[]string{"direction", "mode"}, nil,
+		),
+		decryptErrors: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "decrypt_errors_total"),
+			"Number of kTLS records which failed to decrypt.",
+			nil, nil,
+		),
+		rxDeviceResync: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "rx_device_resync_total"),
+			"Number of kTLS RX resyncs sent to NICs handling cryptography.",
+			nil, nil,
+		),
+		decryptRetries: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "decrypt_retries_total"),
+			"Number of kTLS RX records which had to be re-decrypted due to TLS_RX_EXPECT_NO_PAD mis-prediction.",
+			nil, nil,
+		),
+		rxNoPadViolated: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "rx_no_pad_violations_total"),
+			"Number of kTLS RX data records which had to be re-decrypted due to TLS_RX_EXPECT_NO_PAD mis-prediction.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ktlsCollector) Update(ch chan<- prometheus.Metric) error {
+	stat, err := c.fs.NewTLSStat()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("kTLS not available, tls module not loaded")
+			return ErrNoData
+		}
+		return fmt.Errorf("failed to read /proc/net/tls_stat: %w", err)
+	}
+
+	for _, s := range []struct {
+		direction, mode  string
+		current, session int
+	}{
+		{"tx", "sw", stat.TLSCurrTxSw, stat.TLSTxSw},
+		{"rx", "sw", stat.TLSCurrRxSw, stat.TLSRxSw},
+		{"tx", "device", stat.TLSCurrTxDevice, stat.TLSTxDevice},
+		{"rx", "device", stat.TLSCurrRxDevice, stat.TLSRxDevice},
+	} {
+		ch <- prometheus.MustNewConstMetric(c.currentSessions, prometheus.GaugeValue, float64(s.current), s.direction, s.mode)
+		ch <- prometheus.MustNewConstMetric(c.sessions, prometheus.CounterValue, float64(s.session), s.direction, s.mode)
+	}
+	ch <- prometheus.MustNewConstMetric(c.decryptErrors, prometheus.CounterValue, float64(stat.TLSDecryptError))
+	ch <- prometheus.MustNewConstMetric(c.rxDeviceResync, prometheus.CounterValue, float64(stat.TLSRxDeviceResync))
+	ch <- prometheus.MustNewConstMetric(c.decryptRetries, prometheus.CounterValue, float64(stat.TLSDecryptRetry))
+	ch <- prometheus.MustNewConstMetric(c.rxNoPadViolated, prometheus.CounterValue, float64(stat.TLSRxNoPadViolation))
+
+	return nil
+}
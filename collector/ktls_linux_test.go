@@ -0,0 +1,80 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noktls
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testKTLSCollector struct {
+	kc Collector
+}
+
+func (c testKTLSCollector) Collect(ch chan<- prometheus.Metric) {
+	c.kc.Update(ch)
+}
+
+func (c testKTLSCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestKTLSStats(t *testing.T) {
+	testcase := `# HELP node_ktls_current_sessions Number of kTLS sessions currently installed, by direction and whether the host (sw) or NIC (device) handles cryptography.
+	# TYPE node_ktls_current_sessions gauge
+	node_ktls_current_sessions{direction="rx",mode="device"} 1
+	node_ktls_current_sessions{direction="rx",mode="sw"} 5
+	node_ktls_current_sessions{direction="tx",mode="device"} 2
+	node_ktls_current_sessions{direction="tx",mode="sw"} 5
+	# HELP node_ktls_decrypt_errors_total Number of kTLS records which failed to decrypt.
+	# TYPE node_ktls_decrypt_errors_total counter
+	node_ktls_decrypt_errors_total 3
+	# HELP node_ktls_decrypt_retries_total Number of kTLS RX records which had to be re-decrypted due to TLS_RX_EXPECT_NO_PAD mis-prediction.
+	# TYPE node_ktls_decrypt_retries_total counter
+	node_ktls_decrypt_retries_total 0
+	# HELP node_ktls_rx_device_resync_total Number of kTLS RX resyncs sent to NICs handling cryptography.
+	# TYPE node_ktls_rx_device_resync_total counter
+	node_ktls_rx_device_resync_total 7
+	# HELP node_ktls_rx_no_pad_violations_total Number of kTLS RX data records which had to be re-decrypted due to TLS_RX_EXPECT_NO_PAD mis-prediction.
+	# TYPE node_ktls_rx_no_pad_violations_total counter
+	node_ktls_rx_no_pad_violations_total 0
+	# HELP node_ktls_sessions_total Number of kTLS sessions opened, by direction and whether the host (sw) or NIC (device) handles cryptography.
+	# TYPE node_ktls_sessions_total counter
+	node_ktls_sessions_total{direction="rx",mode="device"} 31
+	node_ktls_sessions_total{direction="rx",mode="sw"} 118
+	node_ktls_sessions_total{direction="tx",mode="device"} 33
+	node_ktls_sessions_total{direction="tx",mode="sw"} 120
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewKTLSCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testKTLSCollector{kc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nokubelet
+
+package collector
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	kubeletCertPath = kingpin.Flag("collector.kubelet.cert-path", "Path to the kubelet client certificate.").Default("/var/lib/kubelet/pki/kubelet-client-current.pem").String()
+)
+
+type kubeletCertCollector struct {
+	expiry *prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("kubelet", defaultDisabled, NewKubeletCertCollector)
+}
+
+// NewKubeletCertCollector returns a new Collector exposing the kubelet client certificate expiry.
+func NewKubeletCertCollector(logger *slog.Logger) (Collector, error) {
+	return &kubeletCertCollector{
+		expiry: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubelet", "client_cert_expiry_seconds"),
+			"Expiry time (NotAfter) of the kubelet client certificate in unixtime.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *kubeletCertCollector) Update(ch chan<- prometheus.Metric) error {
+	path := rootfsFilePath(*kubeletCertPath)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("kubelet client certificate not found", "path", path)
+			return ErrNoData
+		}
+		return err
+	}
+
+	cert, err := parseFirstPEMCertificate(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse kubelet client certificate %s: %w", path, err)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.expiry, prometheus.GaugeValue, float64(cert.NotAfter.Unix()))
+	return nil
+}
+
+// parseFirstPEMCertificate returns the first certificate of a PEM bundle.
+// The kubelet stores the certificate together with its private key in
+// the same file.
+func parseFirstPEMCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nokubelet
+
+package collector
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testKubeletCertCollector struct {
+	kc Collector
+}
+
+func (c testKubeletCertCollector) Collect(ch chan<- prometheus.Metric) {
+	c.kc.Update(ch)
+}
+
+func (c testKubeletCertCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestKubeletCertExpiry(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "system:node:worker-1"},
+		NotBefore:    time.Unix(1700000000, 0),
+		NotAfter:     time.Unix(1731536000, 0),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The private key is stored in the same file, put it first to check
+	// that it is skipped.
+	root := t.TempDir()
+	data := append(
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...,
+	)
+	if err := os.WriteFile(filepath.Join(root, "kubelet-client-current.pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testcase := `# HELP node_kubelet_client_cert_expiry_seconds Expiry time (NotAfter) of the kubelet client certificate in unixtime.
+	# TYPE node_kubelet_client_cert_expiry_seconds gauge
+	node_kubelet_client_cert_expiry_seconds 1.731536e+09
+	`
+	defer func(rootfs, cert string) {
+		*rootfsPath = rootfs
+		*kubeletCertPath = cert
+	}(*rootfsPath, *kubeletCertPath)
+	*rootfsPath = root
+	*kubeletCertPath = "/kubelet-client-current.pem"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewKubeletCertCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testKubeletCertCollector{kc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nol2tp
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Constants from include/uapi/linux/l2tp.h.
+const (
+	l2tpFamily        = "l2tp"
+	l2tpCmdTunnelGet  = 4
+	l2tpCmdSessionGet = 8
+
+	l2tpAttrConnID    = 9
+	l2tpAttrSessionID = 11
+	l2tpAttrStats     = 30
+
+	l2tpAttrTxPackets     = 1
+	l2tpAttrTxBytes       = 2
+	l2tpAttrTxErrors      = 3
+	l2tpAttrRxPackets     = 4
+	l2tpAttrRxBytes       = 5
+	l2tpAttrRxSeqDiscards = 6
+	l2tpAttrRxOOSPackets  = 7
+	l2tpAttrRxErrors      = 8
+)
+
+// l2tpStats holds the statistics of an L2TP tunnel or session. sessionID is
+// empty for tunnels.
+type l2tpStats struct {
+	tunnelID      string
+	sessionID     string
+	txPackets     uint64
+	txBytes       uint64
+	txErrors      uint64
+	rxPackets     uint64
+	rxBytes       uint64
+	rxErrors      uint64
+	rxSeqDiscards uint64
+	rxOOSPackets  uint64
+}
+
+type l2tpCollector struct {
+	tunnelDescs  map[string]*prometheus.Desc
+	sessionDescs map[string]*prometheus.Desc
+	logger       *slog.Logger
+}
+
+func init() {
+	registerCollector("l2tp", defaultDisabled, NewL2TPCollector)
+}
+
+// NewL2TPCollector returns a new Collector exposing L2TP tunnel and session statistics.
+func NewL2TPCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "l2tp"
+
+	descs := func(kind string, labels []string) map[string]*prometheus.Desc {
+		m := map[string]*prometheus.Desc{}
+		for name, help := range map[string]string{
+			"transmit_packets_total":        "Number of packets transmitted by the L2TP %s.",
+			"transmit_bytes_total":          "Number of bytes transmitted by the L2TP %s.",
+			"transmit_errors_total":         "Number of transmit errors of the L2TP %s.",
+			"receive_packets_total":         "Number of packets received by the L2TP %s.",
+			"receive_bytes_total":           "Number of bytes received by the L2TP %s.",
+			"receive_errors_total":          "Number of receive errors of the L2TP %s.",
+			"receive_seq_discards_total":    "Number of received packets of the L2TP %s discarded because of an invalid sequence number.",
+			"receive_out_of_sequence_total": "Number of packets received out of sequence by the L2TP %s.",
+		} {
+			m[name] = prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, subsystem, kind+"_"+name),
+				fmt.Sprintf(help, kind),
+				labels, nil,
+			)
+		}
+		return m
+	}
+
+	return &l2tpCollector{
+		tunnelDescs:  descs("tunnel", []string{"tunnel_id"}),
+		sessionDescs: descs("session", []string{"tunnel_id", "session_id"}),
+		logger:       logger,
+	}, nil
+}
+
+func (c *l2tpCollector) Update(ch chan<- prometheus.Metric) error {
+	tunnels, sessions, err := getL2TPStats()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("l2tp kernel module not loaded")
+			return ErrNoData
+		}
+		return fmt.Errorf("couldn't get L2TP stats: %w", err)
+	}
+
+	for _, t := range tunnels {
+		c.pushStats(ch, c.tunnelDescs, t, t.tunnelID)
+	}
+	for _, s := range sessions {
+		c.pushStats(ch, c.sessionDescs, s, s.tunnelID, s.sessionID)
+	}
+
+	return nil
+}
+
+func (c *l2tpCollector) pushStats(ch chan<- prometheus.Metric, descs map[string]*prometheus.Desc, s l2tpStats, labels ...string) {
+	for name, value := range map[string]uint64{
+		"transmit_packets_total":        s.txPackets,
+		"transmit_bytes_total":          s.txBytes,
+		"transmit_errors_total":         s.txErrors,
+		"receive_packets_total":         s.rxPackets,
+		"receive_bytes_total":           s.rxBytes,
+		"receive_errors_total":          s.rxErrors,
+		"receive_seq_discards_total":    s.rxSeqDiscards,
+		"receive_out_of_sequence_total": s.rxOOSPackets,
+	} {
+		ch <- prometheus.MustNewConstMetric(descs[name], prometheus.CounterValue, float64(value), labels...)
+	}
+}
+
+// getL2TPStats dumps all tunnels and sessions via the l2tp generic netlink
+// family, the same interface used by `ip l2tp show`.
+func getL2TPStats() ([]l2tpStats, []l2tpStats, error) {
+	conn, err := genetlink.Dial(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't connect generic netlink: %w", err)
+	}
+	defer conn.Close()
+
+	family, err := conn.GetFamily(l2tpFamily)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats [2][]l2tpStats
+	for i, cmd := range []uint8{l2tpCmdTunnelGet, l2tpCmdSessionGet} {
+		msgs, err := conn.Execute(genetlink.Message{
+			Header: genetlink.Header{
+				Command: cmd,
+				Version: family.Version,
+			},
+		}, family.ID, netlink.Request|netlink.Dump)
+		if err != nil {
+			return nil, nil, err
+		}
+		stats[i], err = parseL2TPMessages(msgs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return stats[0], stats[1], nil
+}
+
+func parseL2TPMessages(msgs []genetlink.Message) ([]l2tpStats, error) {
+	result := make([]l2tpStats, 0, len(msgs))
+	for _, msg := range msgs {
+		ad, err := netlink.NewAttributeDecoder(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var s l2tpStats
+		for ad.Next() {
+			switch ad.Type() {
+			case l2tpAttrConnID:
+				s.tunnelID = strconv.FormatUint(uint64(ad.Uint32()), 10)
+			case l2tpAttrSessionID:
+				s.sessionID = strconv.FormatUint(uint64(ad.Uint32()), 10)
+			case l2tpAttrStats:
+				ad.Nested(func(nad *netlink.AttributeDecoder) error {
+					for nad.Next() {
+						switch nad.Type() {
+						case l2tpAttrTxPackets:
+							s.txPackets = nad.Uint64()
+						case l2tpAttrTxBytes:
+							s.txBytes = nad.Uint64()
+						case l2tpAttrTxErrors:
+							s.txErrors = nad.Uint64()
+						case l2tpAttrRxPackets:
+							s.rxPackets = nad.Uint64()
+						case l2tpAttrRxBytes:
+							s.rxBytes = nad.Uint64()
+						case l2tpAttrRxErrors:
+							s.rxErrors = nad.Uint64()
+						case l2tpAttrRxSeqDiscards:
+							s.rxSeqDiscards = nad.Uint64()
+						case l2tpAttrRxOOSPackets:
+							s.rxOOSPackets = nad.Uint64()
+						}
+					}
+					return nil
+				})
+			}
+		}
+		if err := ad.Err(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, s)
+	}
+
+	return result, nil
+}
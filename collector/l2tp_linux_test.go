@@ -0,0 +1,67 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nol2tp
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+)
+
+func Test_parseL2TPMessages(t *testing.T) {
+	encode := func(tunnelID, sessionID uint32, txPackets, rxPackets, rxErrors uint64) []byte {
+		ae := netlink.NewAttributeEncoder()
+		ae.Uint32(l2tpAttrConnID, tunnelID)
+		if sessionID != 0 {
+			ae.Uint32(l2tpAttrSessionID, sessionID)
+		}
+		ae.Nested(l2tpAttrStats, func(nae *netlink.AttributeEncoder) error {
+			nae.Uint64(l2tpAttrTxPackets, txPackets)
+			nae.Uint64(l2tpAttrRxPackets, rxPackets)
+			nae.Uint64(l2tpAttrRxErrors, rxErrors)
+			return nil
+		})
+		attrs, err := ae.Encode()
+		if err != nil {
+			panic(err)
+		}
+		return attrs
+	}
+
+	msgs := []genetlink.Message{
+		{Data: encode(1, 0, 100, 90, 0)},
+		{Data: encode(1, 42, 60, 55, 3)},
+	}
+
+	stats, err := parseL2TPMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []l2tpStats{
+		{tunnelID: "1", txPackets: 100, rxPackets: 90},
+		{tunnelID: "1", sessionID: "42", txPackets: 60, rxPackets: 55, rxErrors: 3},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("want %d entries, got %d", len(want), len(stats))
+	}
+	for i := range want {
+		if want[i] != stats[i] {
+			t.Errorf("want %+v, got %+v", want[i], stats[i])
+		}
+	}
+}
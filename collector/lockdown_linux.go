@@ -0,0 +1,82 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nolockdown
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type lockdownCollector struct {
+	mode   *prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("lockdown", defaultDisabled, NewLockdownCollector)
+}
+
+// NewLockdownCollector returns a new Collector exposing the kernel lockdown mode.
+func NewLockdownCollector(logger *slog.Logger) (Collector, error) {
+	return &lockdownCollector{
+		mode: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kernel", "lockdown_mode"),
+			"Kernel lockdown mode: 0 = none, 1 = integrity, 2 = confidentiality.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *lockdownCollector) Update(ch chan<- prometheus.Metric) error {
+	data, err := os.ReadFile(sysFilePath("kernel/security/lockdown"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("kernel lockdown not available")
+			return ErrNoData
+		}
+		return fmt.Errorf("failed to read kernel lockdown mode: %w", err)
+	}
+	mode, err := parseLockdownMode(string(data))
+	if err != nil {
+		return err
+	}
+	ch <- prometheus.MustNewConstMetric(c.mode, prometheus.GaugeValue, mode)
+
+	return nil
+}
+
+// parseLockdownMode parses the content of /sys/kernel/security/lockdown,
+// e.g. "none [integrity] confidentiality", where the active mode is
+// enclosed in brackets.
+func parseLockdownMode(data string) (float64, error) {
+	for _, field := range strings.Fields(data) {
+		switch field {
+		case "[none]":
+			return 0, nil
+		case "[integrity]":
+			return 1, nil
+		case "[confidentiality]":
+			return 2, nil
+		}
+	}
+	return 0, fmt.Errorf("unable to parse kernel lockdown mode %q", strings.TrimSpace(data))
+}
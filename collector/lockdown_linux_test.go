@@ -0,0 +1,78 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nolockdown
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testLockdownCollector struct {
+	lc Collector
+}
+
+func (c testLockdownCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lc.Update(ch)
+}
+
+func (c testLockdownCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestLockdownStats(t *testing.T) {
+	testcase := `# HELP node_kernel_lockdown_mode Kernel lockdown mode: 0 = none, 1 = integrity, 2 = confidentiality.
+	# TYPE node_kernel_lockdown_mode gauge
+	node_kernel_lockdown_mode 1
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewLockdownCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testLockdownCollector{lc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseLockdownMode(t *testing.T) {
+	for data, want := range map[string]float64{
+		"[none] integrity confidentiality\n": 0,
+		"none integrity [confidentiality]\n": 2,
+	} {
+		got, err := parseLockdownMode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%q: want %v, got %v", data, want, got)
+		}
+	}
+
+	if _, err := parseLockdownMode("none integrity confidentiality\n"); err == nil {
+		t.Error("expected an error without a selected mode")
+	}
+}
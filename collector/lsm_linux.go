@@ -0,0 +1,64 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nolsm
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type lsmCollector struct {
+	info   *prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("lsm", defaultDisabled, NewLSMCollector)
+}
+
+// NewLSMCollector returns a new Collector exposing the active Linux Security
+// Modules.
+func NewLSMCollector(logger *slog.Logger) (Collector, error) {
+	return &lsmCollector{
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kernel", "lsm_info"),
+			"Active Linux Security Modules in initialization order, from /sys/kernel/security/lsm.",
+			[]string{"lsm_list"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *lsmCollector) Update(ch chan<- prometheus.Metric) error {
+	// securityfs is usually mounted on /sys/kernel/security, the lsm file
+	// exists since Linux 4.15.
+	data, err := os.ReadFile(sysFilePath("kernel/security/lsm"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("active LSMs not available")
+			return ErrNoData
+		}
+		return fmt.Errorf("failed to read active LSMs: %w", err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1, strings.TrimSpace(string(data)))
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nomcast
+
+package collector
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type mcastMembership struct {
+	device string
+	group  string
+}
+
+type mcastCollector struct {
+	groups     *prometheus.Desc
+	membership *prometheus.Desc
+	logger     *slog.Logger
+}
+
+func init() {
+	registerCollector("mcast", defaultDisabled, NewMcastCollector)
+}
+
+// NewMcastCollector returns a new Collector exposing multicast group memberships.
+func NewMcastCollector(logger *slog.Logger) (Collector, error) {
+	return &mcastCollector{
+		groups: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "network", "multicast_groups"),
+			"Number of link layer multicast groups joined by the network device, from /proc/net/dev_mcast.",
+			[]string{"device"}, nil,
+		),
+		membership: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "network", "multicast_group_info"),
+			"IPv4 and IPv6 multicast group memberships of the network device, from /proc/net/igmp and /proc/net/igmp6.",
+			[]string{"device", "group_address"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *mcastCollector) Update(ch chan<- prometheus.Metric) error {
+	groups, err := readMcastFile(procFilePath("net/dev_mcast"), parseDevMcast)
+	if err != nil {
+		return err
+	}
+	for device, count := range groups {
+		ch <- prometheus.MustNewConstMetric(c.groups, prometheus.GaugeValue, float64(count), device)
+	}
+
+	for _, f := range []struct {
+		file  string
+		parse func(io.Reader) ([]mcastMembership, error)
+	}{
+		{"net/igmp", parseIGMP},
+		{"net/igmp6", parseIGMP6},
+	} {
+		memberships, err := readMcastFile(procFilePath(f.file), f.parse)
+		if err != nil {
+			return err
+		}
+		for _, m := range memberships {
+			ch <- prometheus.MustNewConstMetric(c.membership, prometheus.GaugeValue, 1, m.device, m.group)
+		}
+	}
+
+	return nil
+}
+
+// readMcastFile opens and parses a file, treating missing files (e.g. no
+// IPv6 support) as empty.
+func readMcastFile[T any](path string, parse func(io.Reader) (T, error)) (T, error) {
+	var empty T
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return empty, nil
+		}
+		return empty, err
+	}
+	defer file.Close()
+
+	result, err := parse(file)
+	if err != nil {
+		return empty, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// parseDevMcast counts the link layer multicast addresses per device from
+// /proc/net/dev_mcast, with lines like "2 eth0 1 0 01005e000001".
+func parseDevMcast(r io.Reader) (map[string]int, error) {
+	groups := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			return nil, fmt.Errorf("unexpected line %q", scanner.Text())
+		}
+		groups[fields[1]]++
+	}
+	return groups, scanner.Err()
+}
+
+// parseIGMP parses /proc/net/igmp, where a device line is followed by one
+// indented line per group with the address in little endian hex.
+func parseIGMP(r io.Reader) ([]mcastMembership, error) {
+	var (
+		memberships []mcastMembership
+		device      string
+	)
+
+	scanner := bufio.NewScanner(r)
+	// Skip the header.
+	scanner.Scan()
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "\t\t") {
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("unexpected device line %q", line)
+			}
+			device = strings.TrimSuffix(fields[1], ":")
+			continue
+		}
+
+		b, err := hex.DecodeString(fields[0])
+		if err != nil || len(b) != net.IPv4len {
+			return nil, fmt.Errorf("invalid group address %q", fields[0])
+		}
+		ip := make(net.IP, net.IPv4len)
+		// The kernel prints the address in network byte order as a host integer.
+		binary.BigEndian.PutUint32(ip, binary.NativeEndian.Uint32(b))
+		memberships = append(memberships, mcastMembership{device: device, group: ip.String()})
+	}
+
+	return memberships, scanner.Err()
+}
+
+// parseIGMP6 parses /proc/net/igmp6 with lines like
+// "2 eth0 ff020000000000000000000000000001 1 0000000C 0".
+func parseIGMP6(r io.Reader) ([]mcastMembership, error) {
+	var memberships []mcastMembership
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("unexpected line %q", scanner.Text())
+		}
+
+		b, err := hex.DecodeString(fields[2])
+		if err != nil || len(b) != net.IPv6len {
+			return nil, fmt.Errorf("invalid group address %q", fields[2])
+		}
+		memberships = append(memberships, mcastMembership{device: fields[1], group: net.IP(b).String()})
+	}
+
+	return memberships, scanner.Err()
+}
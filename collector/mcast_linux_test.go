@@ -0,0 +1,68 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nomcast
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testMcastCollector struct {
+	mc Collector
+}
+
+func (c testMcastCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mc.Update(ch)
+}
+
+func (c testMcastCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestMcastStats(t *testing.T) {
+	testcase := `# HELP node_network_multicast_group_info IPv4 and IPv6 multicast group memberships of the network device, from /proc/net/igmp and /proc/net/igmp6.
+	# TYPE node_network_multicast_group_info gauge
+	node_network_multicast_group_info{device="eth0",group_address="224.0.0.1"} 1
+	node_network_multicast_group_info{device="eth0",group_address="224.0.0.251"} 1
+	node_network_multicast_group_info{device="eth0",group_address="ff02::1"} 1
+	node_network_multicast_group_info{device="eth0",group_address="ff02::1:ff8d:9f4e"} 1
+	node_network_multicast_group_info{device="lo",group_address="224.0.0.1"} 1
+	node_network_multicast_group_info{device="lo",group_address="ff02::1"} 1
+	# HELP node_network_multicast_groups Number of link layer multicast groups joined by the network device, from /proc/net/dev_mcast.
+	# TYPE node_network_multicast_groups gauge
+	node_network_multicast_groups{device="eth0"} 3
+	node_network_multicast_groups{device="lo"} 1
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewMcastCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testMcastCollector{mc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/procfs/sysfs"
+	"golang.org/x/sys/unix"
 )
 
 var (
@@ -112,6 +113,9 @@ func (c *netClassCollector) netClassSysfsUpdate(ch chan<- prometheus.Metric) err
 		pushMetric(ch, c.getFieldDesc("device_id"), "device_id", ifaceInfo.DevID, prometheus.GaugeValue, ifaceInfo.Name)
 		pushMetric(ch, c.getFieldDesc("dormant"), "dormant", ifaceInfo.Dormant, prometheus.GaugeValue, ifaceInfo.Name)
 		pushMetric(ch, c.getFieldDesc("flags"), "flags", ifaceInfo.Flags, prometheus.GaugeValue, ifaceInfo.Name)
+		if ifaceInfo.Flags != nil {
+			pushMetric(ch, c.getFieldDesc("interface_promisc"), "interface_promisc", getPromiscState(uint32(*ifaceInfo.Flags)), prometheus.GaugeValue, ifaceInfo.Name)
+		}
 		pushMetric(ch, c.getFieldDesc("iface_id"), "iface_id", ifaceInfo.IfIndex, prometheus.GaugeValue, ifaceInfo.Name)
 		pushMetric(ch, c.getFieldDesc("iface_link"), "iface_link", ifaceInfo.IfLink, prometheus.GaugeValue, ifaceInfo.Name)
 		pushMetric(ch, c.getFieldDesc("iface_link_mode"), "iface_link_mode", ifaceInfo.LinkMode, prometheus.GaugeValue, ifaceInfo.Name)
@@ -186,3 +190,11 @@ func getAdminState(flags *int64) string {
 
 	return "down"
 }
+
+// getPromiscState returns 1 if IFF_PROMISC is set in the device flags.
+func getPromiscState(flags uint32) uint8 {
+	if flags&unix.IFF_PROMISC != 0 {
+		return 1
+	}
+	return 0
+}
@@ -135,6 +135,12 @@ func (c *netClassCollector) netClassRTNLUpdate(ch chan<- prometheus.Metric) erro
 		pushMetric(ch, c.getFieldDesc("carrier_down_changes_total"), "carrier_down_changes_total", msg.Attributes.CarrierDownCount, prometheus.CounterValue, msg.Attributes.Name)
 		pushMetric(ch, c.getFieldDesc("device_id"), "device_id", ifaceInfo.DevID, prometheus.GaugeValue, msg.Attributes.Name)
 		pushMetric(ch, c.getFieldDesc("flags"), "flags", msg.Flags, prometheus.GaugeValue, msg.Attributes.Name)
+		// The netlink ifi_flags only carry IFF_PROMISC when it was set by
+		// the user, the sysfs flags also reflect promiscuity requested by
+		// packet sockets and bridges.
+		if ifaceInfo.Flags != nil {
+			pushMetric(ch, c.getFieldDesc("interface_promisc"), "interface_promisc", getPromiscState(uint32(*ifaceInfo.Flags)), prometheus.GaugeValue, msg.Attributes.Name)
+		}
 		pushMetric(ch, c.getFieldDesc("iface_id"), "iface_id", msg.Index, prometheus.GaugeValue, msg.Attributes.Name)
 		pushMetric(ch, c.getFieldDesc("iface_link_mode"), "iface_link_mode", msg.Attributes.LinkMode, prometheus.GaugeValue, msg.Attributes.Name)
 		pushMetric(ch, c.getFieldDesc("dormant"), "dormant", msg.Attributes.LinkMode, prometheus.GaugeValue, msg.Attributes.Name)
@@ -229,10 +235,10 @@ func getSysfsAttributes(links []rtnetlink.LinkMessage) (sysfs.NetClass, error) {
 		ifName := msg.Attributes.Name
 		devPath := filepath.Join("/sys", "class", "net", ifName)
 
-		// These three attributes hold a device-specific lock when
-		// accessed, not the RTNL lock, so they are much less impactful
-		// than reading most of the other attributes from sysfs.
-		for _, attr := range []string{"addr_assign_type", "dev_id", "name_assign_type"} {
+		// These attributes hold a device-specific lock when accessed,
+		// not the RTNL lock, so they are much less impactful than
+		// reading most of the other attributes from sysfs.
+		for _, attr := range []string{"addr_assign_type", "dev_id", "flags", "name_assign_type"} {
 			if err := sysfs.ParseNetClassAttribute(devPath, attr, &interfaceClass); err != nil {
 				return nil, err
 			}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonetlink
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// netlinkProtocols maps the netlink protocol numbers of include/uapi/linux/netlink.h to their names.
+var netlinkProtocols = map[uint64]string{
+	0:  "route",
+	2:  "usersock",
+	4:  "sock_diag",
+	5:  "nflog",
+	6:  "xfrm",
+	7:  "selinux",
+	8:  "iscsi",
+	9:  "audit",
+	10: "fib_lookup",
+	11: "connector",
+	12: "netfilter",
+	13: "ip6_fw",
+	14: "dnrtmsg",
+	15: "kobject_uevent",
+	16: "generic",
+	18: "scsitransport",
+	19: "ecryptfs",
+	20: "rdma",
+	21: "crypto",
+	22: "smc",
+}
+
+// netlinkSocketStats holds the number of sockets and their receive queue
+// usage for one netlink protocol.
+type netlinkSocketStats struct {
+	sockets  uint64
+	rmemUsed uint64
+}
+
+type netlinkCollector struct {
+	sockets  *prometheus.Desc
+	rmemUsed *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("netlink", defaultDisabled, NewNetlinkCollector)
+}
+
+// NewNetlinkCollector returns a new Collector exposing netlink socket statistics.
+func NewNetlinkCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "netlink"
+
+	return &netlinkCollector{
+		sockets: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "sockets"),
+			"Number of netlink sockets by protocol.",
+			[]string{"protocol"}, nil,
+		),
+		rmemUsed: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "sockets_rmem_bytes"),
+			"Memory used by the receive queues of netlink sockets by protocol in bytes.",
+			[]string{"protocol"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *netlinkCollector) Update(ch chan<- prometheus.Metric) error {
+	file, err := os.Open(procFilePath("net/netlink"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("netlink statistics not found", "err", err)
+			return ErrNoData
+		}
+		return err
+	}
+	defer file.Close()
+
+	stats, err := parseNetlinkSockets(file)
+	if err != nil {
+		return fmt.Errorf("failed to parse /proc/net/netlink: %w", err)
+	}
+	for protocol, s := range stats {
+		ch <- prometheus.MustNewConstMetric(c.sockets, prometheus.GaugeValue, float64(s.sockets), protocol)
+		ch <- prometheus.MustNewConstMetric(c.rmemUsed, prometheus.GaugeValue, float64(s.rmemUsed), protocol)
+	}
+
+	return nil
+}
+
+// parseNetlinkSockets parses /proc/net/netlink, which has one socket per line:
+// "sk Eth Pid Groups Rmem Wmem Dump Locks Drops Inode".
+func parseNetlinkSockets(r io.Reader) (map[string]*netlinkSocketStats, error) {
+	stats := make(map[string]*netlinkSocketStats)
+
+	scanner := bufio.NewScanner(r)
+	// Skip the header.
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			return nil, fmt.Errorf("unexpected line %q", scanner.Text())
+		}
+		proto, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid protocol in line %q: %w", scanner.Text(), err)
+		}
+		rmem, err := strconv.ParseUint(fields[4], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rmem in line %q: %w", scanner.Text(), err)
+		}
+
+		name, ok := netlinkProtocols[proto]
+		if !ok {
+			name = strconv.FormatUint(proto, 10)
+		}
+		s, ok := stats[name]
+		if !ok {
+			s = &netlinkSocketStats{}
+			stats[name] = s
+		}
+		s.sockets++
+		s.rmemUsed += rmem
+	}
+
+	return stats, scanner.Err()
+}
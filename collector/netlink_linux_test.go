@@ -0,0 +1,48 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonetlink
+
+package collector
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNetlinkSockets(t *testing.T) {
+	const netlink = `sk               Eth Pid        Groups   Rmem     Wmem     Dump  Locks    Drops    Inode
+000000001b07d7e7 0   0          00000000 0        0        0     2        0        4
+00000000a1b2c3d4 0   812        00000551 2304     0        0     2        0        21734
+0000000017dcd3d0 4   0          00000000 0        0        0     2        0        589
+00000000cdc060d2 15  1          00000002 0        0        0     2        0        18231
+00000000f00dbeef 15  0          00000000 0        0        0     2        0        18230
+000000004b1d2e3f 31  0          00000000 0        0        0     2        0        31001
+`
+
+	stats, err := parseNetlinkSockets(strings.NewReader(netlink))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*netlinkSocketStats{
+		"route":          {sockets: 2, rmemUsed: 2304},
+		"sock_diag":      {sockets: 1},
+		"kobject_uevent": {sockets: 2},
+		"31":             {sockets: 1},
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Fatalf("want %+v, got %+v", want, stats)
+	}
+}
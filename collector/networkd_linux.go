@@ -0,0 +1,171 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonetworkd
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	networkdDbusObject = "org.freedesktop.network1"
+	networkdDbusPath   = "/org/freedesktop/network1"
+)
+
+var (
+	networkdCarrierStates = []string{"no-carrier", "off", "dormant", "degraded-carrier", "enslaved", "carrier"}
+	networkdAddressStates = []string{"off", "degraded", "routable"}
+	networkdOnlineStates  = []string{"offline", "partial", "online"}
+)
+
+// networkdLink is a link as returned by Manager.ListLinks. Struct elements
+// must be public for the reflection magic of godbus to work.
+type networkdLink struct {
+	Index int32
+	Name  string
+	Path  dbus.ObjectPath
+}
+
+type networkdLinkState struct {
+	name         string
+	carrierState string
+	addressState string
+	onlineState  string
+}
+
+type networkdInterface interface {
+	linkStates() ([]networkdLinkState, error)
+}
+
+type networkdDbus struct {
+	conn *dbus.Conn
+}
+
+type networkdCollector struct {
+	carrierState *prometheus.Desc
+	addressState *prometheus.Desc
+	onlineState  *prometheus.Desc
+	logger       *slog.Logger
+}
+
+func init() {
+	registerCollector("networkd", defaultDisabled, NewNetworkdCollector)
+}
+
+// NewNetworkdCollector returns a new Collector exposing systemd-networkd link states.
+func NewNetworkdCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "networkd"
+
+	return &networkdCollector{
+		carrierState: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "interface_carrier_state"),
+			"systemd-networkd carrier state of the interface.",
+			[]string{"interface", "state"}, nil,
+		),
+		addressState: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "interface_address_state"),
+			"systemd-networkd address state of the interface.",
+			[]string{"interface", "state"}, nil,
+		),
+		onlineState: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "interface_online_state"),
+			"systemd-networkd online state of the interface.",
+			[]string{"interface", "state"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *networkdCollector) Update(ch chan<- prometheus.Metric) error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer conn.Close()
+
+	return c.collectLinkStates(ch, &networkdDbus{conn: conn})
+}
+
+func (c *networkdCollector) collectLinkStates(ch chan<- prometheus.Metric, n networkdInterface) error {
+	links, err := n.linkStates()
+	if err != nil {
+		return fmt.Errorf("unable to get networkd links: %w", err)
+	}
+
+	for _, link := range links {
+		for _, s := range []struct {
+			desc   *prometheus.Desc
+			state  string
+			states []string
+		}{
+			{c.carrierState, link.carrierState, networkdCarrierStates},
+			{c.addressState, link.addressState, networkdAddressStates},
+			{c.onlineState, link.onlineState, networkdOnlineStates},
+		} {
+			if !slices.Contains(s.states, s.state) {
+				// OnlineState is only available since systemd v249.
+				if s.state != "" {
+					c.logger.Debug("unknown networkd link state", "interface", link.name, "state", s.state)
+				}
+				continue
+			}
+			for _, state := range s.states {
+				isCurrent := 0.0
+				if state == s.state {
+					isCurrent = 1.0
+				}
+				ch <- prometheus.MustNewConstMetric(s.desc, prometheus.GaugeValue, isCurrent, link.name, state)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (n *networkdDbus) linkStates() ([]networkdLinkState, error) {
+	var links []networkdLink
+	err := n.conn.Object(networkdDbusObject, networkdDbusPath).
+		Call(networkdDbusObject+".Manager.ListLinks", 0).Store(&links)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]networkdLinkState, 0, len(links))
+	for _, link := range links {
+		object := n.conn.Object(networkdDbusObject, link.Path)
+		state := networkdLinkState{name: link.Name}
+		for property, dst := range map[string]*string{
+			"CarrierState": &state.carrierState,
+			"AddressState": &state.addressState,
+			"OnlineState":  &state.onlineState,
+		} {
+			v, err := object.GetProperty(networkdDbusObject + ".Link." + property)
+			if err != nil {
+				continue
+			}
+			if s, ok := v.Value().(string); ok {
+				*dst = s
+			}
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
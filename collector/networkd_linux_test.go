@@ -0,0 +1,104 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonetworkd
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testNetworkdInterface struct{}
+
+func (n *testNetworkdInterface) linkStates() ([]networkdLinkState, error) {
+	return []networkdLinkState{
+		{name: "eth0", carrierState: "carrier", addressState: "routable", onlineState: "online"},
+		{name: "eth1", carrierState: "no-carrier", addressState: "off", onlineState: "offline"},
+		// systemd before v249 does not expose OnlineState.
+		{name: "bond0", carrierState: "degraded-carrier", addressState: "degraded"},
+	}, nil
+}
+
+type testNetworkdCollector struct {
+	nc *networkdCollector
+}
+
+func (c testNetworkdCollector) Collect(ch chan<- prometheus.Metric) {
+	c.nc.collectLinkStates(ch, &testNetworkdInterface{})
+}
+
+func (c testNetworkdCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestNetworkdLinkStates(t *testing.T) {
+	testcase := `# HELP node_networkd_interface_address_state systemd-networkd address state of the interface.
+	# TYPE node_networkd_interface_address_state gauge
+	node_networkd_interface_address_state{interface="bond0",state="degraded"} 1
+	node_networkd_interface_address_state{interface="bond0",state="off"} 0
+	node_networkd_interface_address_state{interface="bond0",state="routable"} 0
+	node_networkd_interface_address_state{interface="eth0",state="degraded"} 0
+	node_networkd_interface_address_state{interface="eth0",state="off"} 0
+	node_networkd_interface_address_state{interface="eth0",state="routable"} 1
+	node_networkd_interface_address_state{interface="eth1",state="degraded"} 0
+	node_networkd_interface_address_state{interface="eth1",state="off"} 1
+	node_networkd_interface_address_state{interface="eth1",state="routable"} 0
+	# HELP node_networkd_interface_carrier_state systemd-networkd carrier state of the interface.
+	# TYPE node_networkd_interface_carrier_state gauge
+	node_networkd_interface_carrier_state{interface="bond0",state="carrier"} 0
+	node_networkd_interface_carrier_state{interface="bond0",state="degraded-carrier"} 1
+	node_networkd_interface_carrier_state{interface="bond0",state="dormant"} 0
+	node_networkd_interface_carrier_state{interface="bond0",state="enslaved"} 0
+	node_networkd_interface_carrier_state{interface="bond0",state="no-carrier"} 0
+	node_networkd_interface_carrier_state{interface="bond0",state="off"} 0
+	node_networkd_interface_carrier_state{interface="eth0",state="carrier"} 1
+	node_networkd_interface_carrier_state{interface="eth0",state="degraded-carrier"} 0
+	node_networkd_interface_carrier_state{interface="eth0",state="dormant"} 0
+	node_networkd_interface_carrier_state{interface="eth0",state="enslaved"} 0
+	node_networkd_interface_carrier_state{interface="eth0",state="no-carrier"} 0
+	node_networkd_interface_carrier_state{interface="eth0",state="off"} 0
+	node_networkd_interface_carrier_state{interface="eth1",state="carrier"} 0
+	node_networkd_interface_carrier_state{interface="eth1",state="degraded-carrier"} 0
+	node_networkd_interface_carrier_state{interface="eth1",state="dormant"} 0
+	node_networkd_interface_carrier_state{interface="eth1",state="enslaved"} 0
+	node_networkd_interface_carrier_state{interface="eth1",state="no-carrier"} 1
+	node_networkd_interface_carrier_state{interface="eth1",state="off"} 0
+	# HELP node_networkd_interface_online_state systemd-networkd online state of the interface.
+	# TYPE node_networkd_interface_online_state gauge
+	node_networkd_interface_online_state{interface="eth0",state="offline"} 0
+	node_networkd_interface_online_state{interface="eth0",state="online"} 1
+	node_networkd_interface_online_state{interface="eth0",state="partial"} 0
+	node_networkd_interface_online_state{interface="eth1",state="offline"} 1
+	node_networkd_interface_online_state{interface="eth1",state="online"} 0
+	node_networkd_interface_online_state{interface="eth1",state="partial"} 0
+	`
+
+	c, err := NewNetworkdCollector(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testNetworkdCollector{nc: c.(*networkdCollector)})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
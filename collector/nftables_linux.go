@@ -0,0 +1,213 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonftables
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var nftablesSetElements = kingpin.Flag("collector.nftables.set-elements", "Expose the number of elements in nftables sets, this dumps all set elements on every scrape.").Bool()
+
+var nftablesFamilies = map[nftables.TableFamily]string{
+	nftables.TableFamilyINet:   "inet",
+	nftables.TableFamilyIPv4:   "ip",
+	nftables.TableFamilyIPv6:   "ip6",
+	nftables.TableFamilyARP:    "arp",
+	nftables.TableFamilyNetdev: "netdev",
+	nftables.TableFamilyBridge: "bridge",
+}
+
+// nftablesConn is the subset of the nftables connection used by the
+// collector, it is replaced in tests.
+type nftablesConn interface {
+	ListChains() ([]*nftables.Chain, error)
+	GetRules(*nftables.Table, *nftables.Chain) ([]*nftables.Rule, error)
+	GetSets(*nftables.Table) ([]*nftables.Set, error)
+	GetSetElements(*nftables.Set) ([]nftables.SetElement, error)
+	GetObjects(*nftables.Table) ([]nftables.Obj, error)
+}
+
+type nftablesCounts struct {
+	tables, chains, rules, sets, setElements int
+}
+
+type nftablesCollector struct {
+	tables      *prometheus.Desc
+	chains      *prometheus.Desc
+	rules       *prometheus.Desc
+	sets        *prometheus.Desc
+	setElements *prometheus.Desc
+
+	counterPackets *prometheus.Desc
+	counterBytes   *prometheus.Desc
+	quotaUsed      *prometheus.Desc
+	quotaLimit     *prometheus.Desc
+
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("nftables", defaultDisabled, NewNftablesCollector)
+}
+
+// NewNftablesCollector returns a new Collector exposing the size of the nftables ruleset.
+func NewNftablesCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "nftables"
+	newDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, name),
+			help, []string{"family"}, nil,
+		)
+	}
+	// Named objects are stateful objects of a table, referenced by rules.
+	newObjDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, name),
+			help, []string{"family", "table", "name"}, nil,
+		)
+	}
+
+	return &nftablesCollector{
+		tables:      newDesc("tables", "Number of nftables tables."),
+		chains:      newDesc("chains", "Number of nftables chains."),
+		rules:       newDesc("rules", "Number of nftables rules."),
+		sets:        newDesc("sets", "Number of nftables sets."),
+		setElements: newDesc("set_elements", "Number of elements in nftables sets."),
+
+		counterPackets: newObjDesc("counter_packets_total", "Packets counted by the named nftables counter."),
+		counterBytes:   newObjDesc("counter_bytes_total", "Bytes counted by the named nftables counter."),
+		quotaUsed:      newObjDesc("quota_used_bytes", "Bytes consumed of the named nftables quota."),
+		quotaLimit:     newObjDesc("quota_limit_bytes", "Limit of the named nftables quota in bytes."),
+
+		logger: logger,
+	}, nil
+}
+
+func (c *nftablesCollector) Update(ch chan<- prometheus.Metric) error {
+	conn, err := nftables.New(nftables.AsLasting())
+	if err != nil {
+		return fmt.Errorf("failed to open nftables connection: %w", err)
+	}
+	defer conn.CloseLasting()
+
+	tables, err := conn.ListTables()
+	if err != nil {
+		return fmt.Errorf("failed to list nftables tables: %w", err)
+	}
+
+	counts, err := countNftables(conn, tables, *nftablesSetElements)
+	if err != nil {
+		return err
+	}
+
+	for family, name := range nftablesFamilies {
+		n := counts[family]
+		ch <- prometheus.MustNewConstMetric(c.tables, prometheus.GaugeValue, float64(n.tables), name)
+		ch <- prometheus.MustNewConstMetric(c.chains, prometheus.GaugeValue, float64(n.chains), name)
+		ch <- prometheus.MustNewConstMetric(c.rules, prometheus.GaugeValue, float64(n.rules), name)
+		ch <- prometheus.MustNewConstMetric(c.sets, prometheus.GaugeValue, float64(n.sets), name)
+		if *nftablesSetElements {
+			ch <- prometheus.MustNewConstMetric(c.setElements, prometheus.GaugeValue, float64(n.setElements), name)
+		}
+	}
+
+	return c.updateObjects(ch, conn, tables)
+}
+
+func (c *nftablesCollector) updateObjects(ch chan<- prometheus.Metric, conn nftablesConn, tables []*nftables.Table) error {
+	for _, table := range tables {
+		family, ok := nftablesFamilies[table.Family]
+		if !ok {
+			continue
+		}
+
+		objs, err := conn.GetObjects(table)
+		if err != nil {
+			return fmt.Errorf("failed to list objects of table %s: %w", table.Name, err)
+		}
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *nftables.CounterObj:
+				ch <- prometheus.MustNewConstMetric(c.counterPackets, prometheus.CounterValue, float64(o.Packets), family, table.Name, o.Name)
+				ch <- prometheus.MustNewConstMetric(c.counterBytes, prometheus.CounterValue, float64(o.Bytes), family, table.Name, o.Name)
+			case *nftables.QuotaObj:
+				ch <- prometheus.MustNewConstMetric(c.quotaUsed, prometheus.GaugeValue, float64(o.Consumed), family, table.Name, o.Name)
+				ch <- prometheus.MustNewConstMetric(c.quotaLimit, prometheus.GaugeValue, float64(o.Bytes), family, table.Name, o.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// countNftables counts the nftables objects per family. Elements can only be
+// dumped per set and are copied to userspace, so they are only counted if
+// setElements is true.
+func countNftables(conn nftablesConn, tables []*nftables.Table, setElements bool) (map[nftables.TableFamily]*nftablesCounts, error) {
+	counts := make(map[nftables.TableFamily]*nftablesCounts, len(nftablesFamilies))
+	for family := range nftablesFamilies {
+		counts[family] = &nftablesCounts{}
+	}
+
+	for _, table := range tables {
+		n, ok := counts[table.Family]
+		if !ok {
+			continue
+		}
+		n.tables++
+
+		sets, err := conn.GetSets(table)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sets of table %s: %w", table.Name, err)
+		}
+		n.sets += len(sets)
+		if !setElements {
+			continue
+		}
+		for _, set := range sets {
+			elements, err := conn.GetSetElements(set)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list elements of set %s: %w", set.Name, err)
+			}
+			n.setElements += len(elements)
+		}
+	}
+
+	chains, err := conn.ListChains()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables chains: %w", err)
+	}
+	for _, chain := range chains {
+		n, ok := counts[chain.Table.Family]
+		if !ok {
+			continue
+		}
+		n.chains++
+
+		rules, err := conn.GetRules(chain.Table, chain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rules of chain %s: %w", chain.Name, err)
+		}
+		n.rules += len(rules)
+	}
+
+	return counts, nil
+}
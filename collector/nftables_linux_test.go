@@ -0,0 +1,143 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nonftables
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	nftablesTestFilter = &nftables.Table{Name: "filter", Family: nftables.TableFamilyINet}
+	nftablesTestNat    = &nftables.Table{Name: "nat", Family: nftables.TableFamilyIPv6}
+	nftablesTestTables = []*nftables.Table{nftablesTestFilter, nftablesTestNat}
+)
+
+// nftablesTestConn is a ruleset with an inet filter table, holding a set and
+// named objects, and an ip6 nat table.
+type nftablesTestConn struct{}
+
+func (nftablesTestConn) ListChains() ([]*nftables.Chain, error) {
+	return []*nftables.Chain{
+		{Name: "input", Table: nftablesTestFilter},
+		{Name: "forward", Table: nftablesTestFilter},
+		{Name: "postrouting", Table: nftablesTestNat},
+	}, nil
+}
+
+func (nftablesTestConn) GetRules(t *nftables.Table, c *nftables.Chain) ([]*nftables.Rule, error) {
+	switch c.Name {
+	case "input":
+		return make([]*nftables.Rule, 3), nil
+	case "forward":
+		return make([]*nftables.Rule, 1), nil
+	}
+	return nil, nil
+}
+
+func (nftablesTestConn) GetSets(t *nftables.Table) ([]*nftables.Set, error) {
+	if t == nftablesTestFilter {
+		return []*nftables.Set{{Name: "allowed", Table: t}}, nil
+	}
+	return nil, nil
+}
+
+func (nftablesTestConn) GetSetElements(s *nftables.Set) ([]nftables.SetElement, error) {
+	return make([]nftables.SetElement, 2), nil
+}
+
+func (nftablesTestConn) GetObjects(t *nftables.Table) ([]nftables.Obj, error) {
+	if t == nftablesTestFilter {
+		return []nftables.Obj{
+			&nftables.CounterObj{Table: t, Name: "fwded", Packets: 10, Bytes: 1500},
+			&nftables.QuotaObj{Table: t, Name: "monthly", Bytes: 1000, Consumed: 250},
+		}, nil
+	}
+	return nil, nil
+}
+
+func TestCountNftables(t *testing.T) {
+	counts, err := countNftables(nftablesTestConn{}, nftablesTestTables, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for family, want := range map[nftables.TableFamily]nftablesCounts{
+		nftables.TableFamilyINet: {tables: 1, chains: 2, rules: 4, sets: 1, setElements: 2},
+		nftables.TableFamilyIPv6: {tables: 1, chains: 1},
+		nftables.TableFamilyIPv4: {},
+	} {
+		if got := *counts[family]; !reflect.DeepEqual(want, got) {
+			t.Errorf("family %s: want %+v, got %+v", nftablesFamilies[family], want, got)
+		}
+	}
+
+	counts, err = countNftables(nftablesTestConn{}, nftablesTestTables, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := counts[nftables.TableFamilyINet].setElements; got != 0 {
+		t.Errorf("want set elements not to be counted, got %d", got)
+	}
+}
+
+type testNftablesObjectsCollector struct {
+	nc *nftablesCollector
+}
+
+func (c testNftablesObjectsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.nc.updateObjects(ch, nftablesTestConn{}, nftablesTestTables)
+}
+
+func (c testNftablesObjectsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestNftablesObjects(t *testing.T) {
+	testcase := `# HELP node_nftables_counter_bytes_total Bytes counted by the named nftables counter.
+	# TYPE node_nftables_counter_bytes_total counter
+	node_nftables_counter_bytes_total{family="inet",name="fwded",table="filter"} 1500
+	# HELP node_nftables_counter_packets_total Packets counted by the named nftables counter.
+	# TYPE node_nftables_counter_packets_total counter
+	node_nftables_counter_packets_total{family="inet",name="fwded",table="filter"} 10
+	# HELP node_nftables_quota_limit_bytes Limit of the named nftables quota in bytes.
+	# TYPE node_nftables_quota_limit_bytes gauge
+	node_nftables_quota_limit_bytes{family="inet",name="monthly",table="filter"} 1000
+	# HELP node_nftables_quota_used_bytes Bytes consumed of the named nftables quota.
+	# TYPE node_nftables_quota_used_bytes gauge
+	node_nftables_quota_used_bytes{family="inet",name="monthly",table="filter"} 250
+	`
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewNftablesCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testNftablesObjectsCollector{nc: c.(*nftablesCollector)})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noovs
+
+package collector
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/mdlayher/genetlink"
+	"github.com/mdlayher/netlink"
+)
+
+func Test_parseOVSDatapathMessages(t *testing.T) {
+	encode := func(name string, hit, missed, lost, flows uint64) []byte {
+		stats := make([]byte, ovsDatapathStatsLen)
+		binary.NativeEndian.PutUint64(stats[0:8], hit)
+		binary.NativeEndian.PutUint64(stats[8:16], missed)
+		binary.NativeEndian.PutUint64(stats[16:24], lost)
+		binary.NativeEndian.PutUint64(stats[24:32], flows)
+
+		ae := netlink.NewAttributeEncoder()
+		ae.String(ovsDatapathAttrName, name)
+		ae.Bytes(ovsDatapathAttrStats, stats)
+		attrs, err := ae.Encode()
+		if err != nil {
+			panic(err)
+		}
+		return append(make([]byte, ovsHeaderLen), attrs...)
+	}
+
+	msgs := []genetlink.Message{
+		{Data: encode("ovs-system", 1000, 20, 1, 42)},
+		{Data: encode("br-int", 5, 0, 0, 3)},
+	}
+
+	datapaths, err := parseOVSDatapathMessages(msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ovsDatapathStats{
+		{name: "ovs-system", hit: 1000, missed: 20, lost: 1, flows: 42},
+		{name: "br-int", hit: 5, missed: 0, lost: 0, flows: 3},
+	}
+	if len(datapaths) != len(want) {
+		t.Fatalf("want %d datapaths, got %d", len(want), len(datapaths))
+	}
+	for i := range want {
+		if want[i] != datapaths[i] {
+			t.Errorf("want datapath %+v, got %+v", want[i], datapaths[i])
+		}
+	}
+
+	if _, err := parseOVSDatapathMessages([]genetlink.Message{{Data: []byte{0}}}); err == nil {
+		t.Error("expected error for short message")
+	}
+}
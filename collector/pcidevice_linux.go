@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -131,6 +132,15 @@ var (
 		valueType: prometheus.GaugeValue,
 	}
 
+	pcideviceSriovPhysfnDesc = typedDesc{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, pcideviceSubsystem, "sriov_physfn_info"),
+			"Physical Function (PF) of an SR-IOV Virtual Function, value is always 1.",
+			append(pcideviceLabelNames, "pf_segment", "pf_bus", "pf_device", "pf_function"), nil,
+		),
+		valueType: prometheus.GaugeValue,
+	}
+
 	pcideviceNumaNodeDesc = typedDesc{
 		desc: prometheus.NewDesc(
 			prometheus.BuildFQName(namespace, pcideviceSubsystem, "numa_node"),
@@ -365,6 +375,11 @@ func (c *pcideviceCollector) Update(ch chan<- prometheus.Metric) error {
 		ch <- pcideviceSriovTotalvfsDesc.mustNewConstMetric(sriovTotalvfs, device.Location.Strings()...)
 		ch <- pcideviceSriovVfTotalMsixDesc.mustNewConstMetric(sriovVfTotalMsix, device.Location.Strings()...)
 
+		// Virtual Functions link to their Physical Function.
+		if physfn, ok := c.sriovPhysfn(device.Location); ok {
+			ch <- pcideviceSriovPhysfnDesc.mustNewConstMetric(1.0, append(device.Location.Strings(), physfn.Strings()...)...)
+		}
+
 		// Emit power state metrics with state labels only if power state is available
 		if hasPowerState {
 			powerStates := []string{"D0", "D1", "D2", "D3hot", "D3cold", "unknown", "error"}
@@ -397,6 +412,23 @@ func (c *pcideviceCollector) Update(ch chan<- prometheus.Metric) error {
 	return nil
 }
 
+// sriovPhysfn returns the location of the Physical Function of a Virtual
+// Function from the physfn symlink.
+func (c *pcideviceCollector) sriovPhysfn(location sysfs.PciDeviceLocation) (sysfs.PciDeviceLocation, bool) {
+	var physfn sysfs.PciDeviceLocation
+	name := fmt.Sprintf("%04x:%02x:%02x.%x", location.Segment, location.Bus, location.Device, location.Function)
+	target, err := os.Readlink(sysFilePath(filepath.Join("bus/pci/devices", name, "physfn")))
+	if err != nil {
+		return physfn, false
+	}
+	_, err = fmt.Sscanf(filepath.Base(target), "%04x:%02x:%02x.%x", &physfn.Segment, &physfn.Bus, &physfn.Device, &physfn.Function)
+	if err != nil {
+		c.logger.Debug("Failed to parse SR-IOV physfn", "device", name, "target", target, "error", err)
+		return physfn, false
+	}
+	return physfn, true
+}
+
 func (c *pcideviceCollector) collectAerRootPortMetrics(ch chan<- prometheus.Metric) error {
 	rootPortAerCounters, err := c.fs.RootPortAerCounters()
 	if err != nil {
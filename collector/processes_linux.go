@@ -29,6 +29,11 @@ import (
 	"github.com/prometheus/procfs"
 )
 
+// processStates are the states of fs/proc/array.c, which are always exposed
+// so that alerting on e.g. zombie or uninterruptible processes doesn't depend
+// on the presence of such a process.
+var processStates = []string{"R", "S", "D", "T", "t", "X", "Z", "P", "I"}
+
 type processCollector struct {
 	fs           procfs.FS
 	threadAlloc  *prometheus.Desc
@@ -120,7 +125,10 @@ func (c *processCollector) getAllocatedThreads() (int, map[string]int32, int, ma
 	}
 	pids := 0
 	thread := 0
-	procStates := make(map[string]int32)
+	procStates := make(map[string]int32, len(processStates))
+	for _, state := range processStates {
+		procStates[state] = 0
+	}
 	threadStates := make(map[string]int32)
 
 	for _, pid := range p {
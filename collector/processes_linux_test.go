@@ -45,6 +45,9 @@ func TestReadProcessStatus(t *testing.T) {
 
 		t.Fatalf("Process states cannot be nil %v:", states)
 	}
+	if _, ok := states["D"]; !ok {
+		t.Fatalf("Process state D must always be present %v:", states)
+	}
 	maxPid, err := readUintFromFile(procFilePath("sys/kernel/pid_max"))
 	if err != nil {
 		t.Fatalf("Unable to retrieve limit number of maximum pids allowed %v\n", err)
@@ -0,0 +1,138 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noptp
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+// ptpOffsetSamples is the number of PHC and system clock readings taken to
+// determine the offset, the one with the shortest delay is used.
+const ptpOffsetSamples = 5
+
+type ptpCollector struct {
+	info   *prometheus.Desc
+	offset *prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("ptp", defaultDisabled, NewPTPCollector)
+}
+
+// NewPTPCollector returns a new Collector exposing PTP hardware clocks.
+func NewPTPCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "ptp"
+
+	return &ptpCollector{
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "clock_info"),
+			"PTP hardware clock information from /sys/class/ptp.",
+			[]string{"device", "clock_name", "alarms", "external_timestamps", "periodic_outputs", "programmable_pins", "pps_available"}, nil,
+		),
+		offset: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "offset_seconds"),
+			"Offset of the PTP hardware clock from the system clock (CLOCK_REALTIME) in seconds. A clock running in TAI is ahead by the TAI-UTC offset.",
+			[]string{"device"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *ptpCollector) Update(ch chan<- prometheus.Metric) error {
+	devices, err := filepath.Glob(sysFilePath("class/ptp/ptp[0-9]*"))
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		c.logger.Debug("no PTP hardware clocks found")
+		return ErrNoData
+	}
+
+	for _, dir := range devices {
+		device := filepath.Base(dir)
+
+		labels := []string{device}
+		for _, attr := range []string{"clock_name", "n_alarms", "n_external_timestamps", "n_periodic_outputs", "n_programmable_pins", "pps_available"} {
+			value, err := os.ReadFile(filepath.Join(dir, attr))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			labels = append(labels, strings.TrimSpace(string(value)))
+		}
+		ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1, labels...)
+
+		offset, err := readPTPOffset(rootfsFilePath(filepath.Join("/dev", device)))
+		if err != nil {
+			c.logger.Debug("failed to read PTP clock offset", "device", device, "err", err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.offset, prometheus.GaugeValue, offset, device)
+	}
+
+	return nil
+}
+
+// readPTPOffset measures the offset in seconds of the PTP hardware clock from
+// the system clock with the PTP_SYS_OFFSET_EXTENDED2 ioctl (Linux 5.8+).
+func readPTPOffset(path string) (float64, error) {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(fd)
+
+	sysOffset, err := unix.IoctlPtpSysOffsetExtended(fd, ptpOffsetSamples)
+	if err != nil {
+		return 0, fmt.Errorf("PTP_SYS_OFFSET_EXTENDED2 failed: %w", err)
+	}
+
+	return ptpOffset(sysOffset), nil
+}
+
+// ptpOffset returns the offset in seconds of the PHC from the system clock
+// of the sample with the shortest delay, assuming the PHC was read halfway
+// between the two system clock readings.
+func ptpOffset(sysOffset *unix.PtpSysOffsetExtended) float64 {
+	// The timestamps are subtracted as integers, a float64 only has enough
+	// precision for the nanoseconds since the epoch to about 0.25µs.
+	nanoseconds := func(t unix.PtpClockTime) int64 {
+		return t.Sec*1e9 + int64(t.Nsec)
+	}
+	var (
+		offset   float64
+		minDelay int64 = math.MaxInt64
+	)
+	// Every sample holds the system time before, the PHC time and the
+	// system time after reading the PHC.
+	for _, ts := range sysOffset.Ts[:min(sysOffset.Samples, ptpOffsetSamples)] {
+		before, phc, after := nanoseconds(ts[0]), nanoseconds(ts[1]), nanoseconds(ts[2])
+		if delay := after - before; delay < minDelay {
+			minDelay = delay
+			offset = float64(phc-before) - float64(delay)/2
+		}
+	}
+	return offset / 1e9
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noptp
+
+package collector
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestPTPOffset(t *testing.T) {
+	sample := func(before, phc, after int64) [3]unix.PtpClockTime {
+		ts := func(ns int64) unix.PtpClockTime {
+			return unix.PtpClockTime{Sec: 1760000000 + ns/1e9, Nsec: uint32(ns % 1e9)}
+		}
+		return [3]unix.PtpClockTime{ts(before), ts(phc), ts(after)}
+	}
+
+	// The PHC runs 37s ahead, like a clock in TAI. The second sample has
+	// the shortest delay, the third one is beyond the returned samples.
+	sysOffset := &unix.PtpSysOffsetExtended{Samples: 2}
+	sysOffset.Ts[0] = sample(100, 37e9+1100, 2100)
+	sysOffset.Ts[1] = sample(10000, 37e9+10300, 10400)
+	sysOffset.Ts[2] = sample(20000, 38e9+20050, 20100)
+
+	got := ptpOffset(sysOffset)
+	if want := 37.0000001; math.Abs(got-want) > 1e-12 {
+		t.Errorf("want offset %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noquota
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+// Constants from include/uapi/linux/quota.h.
+const (
+	quotaGetNextQuota uint32 = 0x800009
+	quotaSubCmdShift         = 8
+	quotaBlockSize           = 1024
+)
+
+// quotaTypes maps the quota type passed to quotactl(2) to its name and the
+// mount options enabling it.
+var quotaTypes = []struct {
+	id      int
+	name    string
+	options []string
+}{
+	{0, "user", []string{"usrquota", "usrjquota", "uquota", "quota"}},
+	{1, "group", []string{"grpquota", "grpjquota", "gquota"}},
+	{2, "project", []string{"prjquota", "pquota"}},
+}
+
+// ifNextDqblk mirrors struct if_nextdqblk.
+type ifNextDqblk struct {
+	BHardLimit uint64
+	BSoftLimit uint64
+	CurSpace   uint64
+	IHardLimit uint64
+	ISoftLimit uint64
+	CurInodes  uint64
+	BTime      uint64
+	ITime      uint64
+	Valid      uint32
+	ID         uint32
+}
+
+type quotaMount struct {
+	device     string
+	mountPoint string
+	options    map[string]struct{}
+}
+
+type quotaCollector struct {
+	used   *prometheus.Desc
+	limit  *prometheus.Desc
+	logger *slog.Logger
+}
+
+func init() {
+	registerCollector("quota", defaultDisabled, NewQuotaCollector)
+}
+
+// NewQuotaCollector returns a new Collector exposing filesystem disk quota usage.
+func NewQuotaCollector(logger *slog.Logger) (Collector, error) {
+	labels := []string{"device", "mountpoint", "quota_type", "id"}
+
+	return &quotaCollector{
+		used: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "filesystem", "quota_used_bytes"),
+			"Disk space used by the quota owner in bytes.",
+			labels, nil,
+		),
+		limit: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "filesystem", "quota_limit_bytes"),
+			"Hard disk space limit of the quota owner in bytes, only exposed for quota owners with a limit.",
+			labels, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *quotaCollector) Update(ch chan<- prometheus.Metric) error {
+	mounts, err := parseQuotaMounts(procFilePath("mounts"))
+	if err != nil {
+		return err
+	}
+	if len(mounts) == 0 {
+		return ErrNoData
+	}
+
+	for _, m := range mounts {
+		for _, qt := range quotaTypes {
+			if !m.hasAnyOption(qt.options) {
+				continue
+			}
+			if err := c.updateQuotas(ch, m, qt.id, qt.name); err != nil {
+				// Reading the quotas of other users requires
+				// CAP_SYS_ADMIN.
+				if errors.Is(err, unix.EPERM) {
+					c.logger.Debug("not permitted to read quotas, node_exporter must run as root", "mountpoint", m.mountPoint)
+					return ErrNoData
+				}
+				c.logger.Debug("failed to get quotas", "mountpoint", m.mountPoint, "quota_type", qt.name, "err", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *quotaCollector) updateQuotas(ch chan<- prometheus.Metric, m quotaMount, quotaType int, typeName string) error {
+	device, err := unix.BytePtrFromString(m.device)
+	if err != nil {
+		return err
+	}
+	cmd := quotaGetNextQuota<<quotaSubCmdShift | uint32(quotaType)
+
+	// Q_GETNEXTQUOTA returns the quota of the first ID greater than or
+	// equal to the one requested, ENOENT marks the end of the list.
+	var id uint32
+	for {
+		var dq ifNextDqblk
+		_, _, errno := unix.Syscall6(unix.SYS_QUOTACTL, uintptr(cmd), uintptr(unsafe.Pointer(device)),
+			uintptr(id), uintptr(unsafe.Pointer(&dq)), 0, 0)
+		if errno == unix.ENOENT {
+			return nil
+		}
+		if errno != 0 {
+			return errno
+		}
+
+		idLabel := strconv.FormatUint(uint64(dq.ID), 10)
+		ch <- prometheus.MustNewConstMetric(c.used, prometheus.GaugeValue, float64(dq.CurSpace), m.device, m.mountPoint, typeName, idLabel)
+		// A hard limit of 0 means the quota owner is unlimited.
+		if dq.BHardLimit > 0 {
+			ch <- prometheus.MustNewConstMetric(c.limit, prometheus.GaugeValue, float64(dq.BHardLimit*quotaBlockSize), m.device, m.mountPoint, typeName, idLabel)
+		}
+
+		if dq.ID == ^uint32(0) {
+			return nil
+		}
+		id = dq.ID + 1
+	}
+}
+
+func (m quotaMount) hasAnyOption(options []string) bool {
+	for _, o := range options {
+		if _, ok := m.options[o]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQuotaMounts returns the mounts from a /proc/mounts style file that
+// have any quota mount option set.
+func parseQuotaMounts(path string) ([]quotaMount, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var mounts []quotaMount
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("malformed mount point information: %q", scanner.Text())
+		}
+
+		m := quotaMount{
+			device:     fields[0],
+			mountPoint: rootfsStripPrefix(strings.ReplaceAll(fields[1], "\\040", " ")),
+			options:    make(map[string]struct{}),
+		}
+		for _, o := range strings.Split(fields[3], ",") {
+			// Journaled quota options carry the quota file name, e.g. usrjquota=aquota.user.
+			name, _, _ := strings.Cut(o, "=")
+			m.options[name] = struct{}{}
+		}
+
+		for _, qt := range quotaTypes {
+			if m.hasAnyOption(qt.options) {
+				mounts = append(mounts, m)
+				break
+			}
+		}
+	}
+
+	return mounts, scanner.Err()
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noquota
+
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseQuotaMounts(t *testing.T) {
+	mounts := `/dev/sda1 / ext4 rw,relatime 0 0
+/dev/sdb1 /home ext4 rw,relatime,usrjquota=aquota.user,grpjquota=aquota.group,jqfmt=vfsv1 0 0
+/dev/sdc1 /srv/project\040data xfs rw,relatime,prjquota 0 0
+tmpfs /tmp tmpfs rw,nosuid,nodev 0 0
+`
+	path := filepath.Join(t.TempDir(), "mounts")
+	if err := os.WriteFile(path, []byte(mounts), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := parseQuotaMounts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []quotaMount{
+		{
+			device:     "/dev/sdb1",
+			mountPoint: "/home",
+			options: map[string]struct{}{
+				"rw": {}, "relatime": {}, "usrjquota": {}, "grpjquota": {}, "jqfmt": {},
+			},
+		},
+		{
+			device:     "/dev/sdc1",
+			mountPoint: "/srv/project data",
+			options: map[string]struct{}{
+				"rw": {}, "relatime": {}, "prjquota": {},
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("want %+v, got %+v", want, got)
+	}
+
+	for _, tc := range []struct {
+		mount quotaMount
+		qtype int
+		want  bool
+	}{
+		{got[0], 0, true},
+		{got[0], 1, true},
+		{got[0], 2, false},
+		{got[1], 0, false},
+		{got[1], 2, true},
+	} {
+		if has := tc.mount.hasAnyOption(quotaTypes[tc.qtype].options); has != tc.want {
+			t.Errorf("%s %s quota: want %v, got %v", tc.mount.mountPoint, quotaTypes[tc.qtype].name, tc.want, has)
+		}
+	}
+}
+
+func TestParseQuotaMountsMissing(t *testing.T) {
+	got, err := parseQuotaMounts(filepath.Join(t.TempDir(), "mounts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("want no mounts, got %+v", got)
+	}
+}
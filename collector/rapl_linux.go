@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -33,7 +34,8 @@ type raplCollector struct {
 	fs     sysfs.FS
 	logger *slog.Logger
 
-	joulesMetricDesc *prometheus.Desc
+	joulesMetricDesc   *prometheus.Desc
+	maxPowerMetricDesc *prometheus.Desc
 }
 
 func init() {
@@ -58,10 +60,17 @@ func NewRaplCollector(logger *slog.Logger) (Collector, error) {
 		[]string{"index", "path", "rapl_zone"}, nil,
 	)
 
+	maxPowerMetricDesc := prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, raplCollectorSubsystem, "max_power_watts"),
+		"Maximum RAPL power of the long term constraint in watts",
+		[]string{"index", "path", "rapl_zone"}, nil,
+	)
+
 	collector := raplCollector{
-		fs:               fs,
-		logger:           logger,
-		joulesMetricDesc: joulesMetricDesc,
+		fs:                 fs,
+		logger:             logger,
+		joulesMetricDesc:   joulesMetricDesc,
+		maxPowerMetricDesc: maxPowerMetricDesc,
 	}
 	return &collector, nil
 }
@@ -99,6 +108,22 @@ func (c *raplCollector) Update(ch chan<- prometheus.Metric) error {
 		} else {
 			ch <- c.joulesMetric(rz, joules)
 		}
+
+		// constraint_0 is the long term (TDP) limit. The file is empty or
+		// missing for zones without a maximum, e.g. most core zones.
+		microWatts, err := readUintFromFile(filepath.Join(rz.Path, "constraint_0_max_power_uw"))
+		if err != nil {
+			c.logger.Debug("Can't read constraint_0_max_power_uw file", "zone", rz.Name, "err", err)
+			continue
+		}
+
+		watts := float64(microWatts) / 1000000.0
+
+		if *raplZoneLabel {
+			ch <- c.maxPowerMetricWithZoneLabel(rz, watts)
+		} else {
+			ch <- c.maxPowerMetric(rz, watts)
+		}
 	}
 	return nil
 }
@@ -136,3 +161,37 @@ func (c *raplCollector) joulesMetricWithZoneLabel(z sysfs.RaplZone, v float64) p
 		z.Name,
 	)
 }
+
+func (c *raplCollector) maxPowerMetric(z sysfs.RaplZone, v float64) prometheus.Metric {
+	index := strconv.Itoa(z.Index)
+	descriptor := prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace,
+			raplCollectorSubsystem,
+			fmt.Sprintf("%s_max_power_watts", SanitizeMetricName(z.Name)),
+		),
+		fmt.Sprintf("Maximum RAPL %s power of the long term constraint in watts", z.Name),
+		[]string{"index", "path"}, nil,
+	)
+
+	return prometheus.MustNewConstMetric(
+		descriptor,
+		prometheus.GaugeValue,
+		v,
+		index,
+		z.Path,
+	)
+}
+
+func (c *raplCollector) maxPowerMetricWithZoneLabel(z sysfs.RaplZone, v float64) prometheus.Metric {
+	index := strconv.Itoa(z.Index)
+
+	return prometheus.MustNewConstMetric(
+		c.maxPowerMetricDesc,
+		prometheus.GaugeValue,
+		v,
+		index,
+		z.Path,
+		z.Name,
+	)
+}
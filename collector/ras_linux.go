@@ -0,0 +1,169 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noras
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EDAC memory controller and PCIe AER error counts are exposed per device by
+// the edac and pcidevice collectors, this collector only exposes the state of
+// the kernel RAS core from debugfs.
+type rasCollector struct {
+	daemonActive   *prometheus.Desc
+	cecErrors      *prometheus.Desc
+	cecOfflined    *prometheus.Desc
+	cecDecayPeriod *prometheus.Desc
+	cecThreshold   *prometheus.Desc
+	logger         *slog.Logger
+}
+
+// rasCECStats holds the statistics of the correctable errors collector.
+type rasCECStats struct {
+	errors          uint64
+	offlinedPages   uint64
+	decayInterval   uint64
+	actionThreshold uint64
+}
+
+func init() {
+	registerCollector("ras", defaultDisabled, NewRASCollector)
+}
+
+// NewRASCollector returns a new Collector exposing the kernel RAS state.
+func NewRASCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "ras"
+
+	return &rasCollector{
+		daemonActive: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "daemon_active"),
+			"Number of userspace consumers of the RAS trace events, e.g. rasdaemon.",
+			nil, nil,
+		),
+		cecErrors: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "cec_correctable_errors_total"),
+			"Number of correctable memory errors seen by the kernel correctable errors collector.",
+			nil, nil,
+		),
+		cecOfflined: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "cec_offlined_pages_total"),
+			"Number of pages offlined by the correctable errors collector after reaching the action threshold.",
+			nil, nil,
+		),
+		cecDecayPeriod: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "cec_decay_interval_seconds"),
+			"Interval at which the correctable errors collector decays its error counts.",
+			nil, nil,
+		),
+		cecThreshold: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "cec_action_threshold"),
+			"Number of correctable errors of a page after which it is offlined.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *rasCollector) Update(ch chan<- prometheus.Metric) error {
+	// The RAS debugfs files are only available with debugfs mounted and
+	// readable, which usually requires root.
+	active, err := readUintFromFile(sysFilePath("kernel/debug/ras/daemon_active"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
+			c.logger.Debug("RAS debugfs not available", "err", err)
+			return ErrNoData
+		}
+		return err
+	}
+	ch <- prometheus.MustNewConstMetric(c.daemonActive, prometheus.GaugeValue, float64(active))
+
+	// The correctable errors collector needs CONFIG_RAS_CEC.
+	f, err := os.Open(sysFilePath("kernel/debug/ras/cec/array"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if errors.Is(err, os.ErrPermission) {
+			c.logger.Debug("Can't access RAS CEC array", "err", err)
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	stats, err := parseRASCECArray(f)
+	if err != nil {
+		return fmt.Errorf("failed to parse RAS CEC array: %w", err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.cecErrors, prometheus.CounterValue, float64(stats.errors))
+	ch <- prometheus.MustNewConstMetric(c.cecOfflined, prometheus.CounterValue, float64(stats.offlinedPages))
+	ch <- prometheus.MustNewConstMetric(c.cecDecayPeriod, prometheus.GaugeValue, float64(stats.decayInterval))
+	ch <- prometheus.MustNewConstMetric(c.cecThreshold, prometheus.GaugeValue, float64(stats.actionThreshold))
+
+	return nil
+}
+
+// parseRASCECArray parses the statistics printed after the page frame
+// entries of /sys/kernel/debug/ras/cec/array:
+//
+//	Stats:
+//	CEs: 12
+//	offlined pages: 1
+//	Flags: 0x0
+//	Decay interval: 86400 seconds
+//	Decays: 3
+//	Action threshold: 1023
+func parseRASCECArray(r io.Reader) (rasCECStats, error) {
+	var stats rasCECStats
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		var dst *uint64
+		switch key {
+		case "CEs":
+			dst = &stats.errors
+		case "offlined pages":
+			dst = &stats.offlinedPages
+		case "Decay interval":
+			dst = &stats.decayInterval
+			value = strings.TrimSuffix(strings.TrimSpace(value), " seconds")
+		case "Action threshold":
+			dst = &stats.actionThreshold
+		default:
+			continue
+		}
+		v, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return stats, fmt.Errorf("invalid value in line %q: %w", scanner.Text(), err)
+		}
+		*dst = v
+	}
+
+	return stats, scanner.Err()
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noras
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testRASCollector struct {
+	rc Collector
+}
+
+func (c testRASCollector) Collect(ch chan<- prometheus.Metric) {
+	c.rc.Update(ch)
+}
+
+func (c testRASCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestRASStats(t *testing.T) {
+	testcase := `# HELP node_ras_cec_action_threshold Number of correctable errors of a page after which it is offlined.
+	# TYPE node_ras_cec_action_threshold gauge
+	node_ras_cec_action_threshold 1023
+	# HELP node_ras_cec_correctable_errors_total Number of correctable memory errors seen by the kernel correctable errors collector.
+	# TYPE node_ras_cec_correctable_errors_total counter
+	node_ras_cec_correctable_errors_total 12
+	# HELP node_ras_cec_decay_interval_seconds Interval at which the correctable errors collector decays its error counts.
+	# TYPE node_ras_cec_decay_interval_seconds gauge
+	node_ras_cec_decay_interval_seconds 86400
+	# HELP node_ras_cec_offlined_pages_total Number of pages offlined by the correctable errors collector after reaching the action threshold.
+	# TYPE node_ras_cec_offlined_pages_total counter
+	node_ras_cec_offlined_pages_total 1
+	# HELP node_ras_daemon_active Number of userspace consumers of the RAS trace events, e.g. rasdaemon.
+	# TYPE node_ras_daemon_active gauge
+	node_ras_daemon_active 1
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewRASCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testRASCollector{rc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nortc
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type rtcCollector struct {
+	drift        *prometheus.Desc
+	hctosys      *prometheus.Desc
+	wakealarm    *prometheus.Desc
+	wakealarmSet *prometheus.Desc
+	maxUserFreq  *prometheus.Desc
+	logger       *slog.Logger
+}
+
+func init() {
+	registerCollector("rtc", defaultDisabled, NewRTCCollector)
+}
+
+// NewRTCCollector returns a new Collector exposing the state of the real
+// time clocks.
+func NewRTCCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "rtc"
+
+	return &rtcCollector{
+		drift: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "clock_drift_seconds"),
+			"Difference between the RTC and the system clock in seconds, with a resolution of one second.",
+			[]string{"device"}, nil,
+		),
+		hctosys: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "hctosys"),
+			"Whether the system clock was set from this RTC during boot.",
+			[]string{"device"}, nil,
+		),
+		wakealarm: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "wakealarm_timestamp_seconds"),
+			"Time of the scheduled wakeup alarm in unixtime.",
+			[]string{"device"}, nil,
+		),
+		wakealarmSet: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "wakealarm_set"),
+			"Whether a wakeup alarm is scheduled.",
+			[]string{"device"}, nil,
+		),
+		maxUserFreq: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "max_user_frequency_hertz"),
+			"Maximum periodic interrupt frequency unprivileged users may request.",
+			[]string{"device"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *rtcCollector) Update(ch chan<- prometheus.Metric) error {
+	devices, err := filepath.Glob(sysFilePath("class/rtc/rtc*"))
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		c.logger.Debug("no real time clocks found")
+		return ErrNoData
+	}
+
+	for _, dir := range devices {
+		device := filepath.Base(dir)
+
+		// The RTC is expected to run in UTC, since_epoch is only meaningful then.
+		sinceEpoch, err := readUintFromFile(filepath.Join(dir, "since_epoch"))
+		if err != nil {
+			// Reading the time fails e.g. for RTCs that were never set.
+			c.logger.Debug("failed to read RTC time", "device", device, "err", err)
+		} else {
+			drift := float64(sinceEpoch) - float64(time.Now().UnixNano())/1e9
+			ch <- prometheus.MustNewConstMetric(c.drift, prometheus.GaugeValue, drift, device)
+		}
+
+		for _, f := range []struct {
+			file string
+			desc *prometheus.Desc
+		}{
+			{"hctosys", c.hctosys},
+			{"max_user_freq", c.maxUserFreq},
+		} {
+			value, err := readUintFromFile(filepath.Join(dir, f.file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(f.desc, prometheus.GaugeValue, float64(value), device)
+		}
+
+		// wakealarm is empty if no alarm is scheduled and missing if the RTC
+		// can't wake the system.
+		wakealarm, err := os.ReadFile(filepath.Join(dir, "wakealarm"))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		set := 0.0
+		if value := strings.TrimSpace(string(wakealarm)); value != "" {
+			timestamp, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid wakealarm of %s: %w", device, err)
+			}
+			set = 1
+			ch <- prometheus.MustNewConstMetric(c.wakealarm, prometheus.GaugeValue, float64(timestamp), device)
+		}
+		ch <- prometheus.MustNewConstMetric(c.wakealarmSet, prometheus.GaugeValue, set, device)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nortc
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testRTCCollector struct {
+	rc Collector
+}
+
+func (c testRTCCollector) Collect(ch chan<- prometheus.Metric) {
+	c.rc.Update(ch)
+}
+
+func (c testRTCCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestRTCStats(t *testing.T) {
+	testcase := `# HELP node_rtc_hctosys Whether the system clock was set from this RTC during boot.
+	# TYPE node_rtc_hctosys gauge
+	node_rtc_hctosys{device="rtc0"} 1
+	node_rtc_hctosys{device="rtc1"} 0
+	# HELP node_rtc_max_user_frequency_hertz Maximum periodic interrupt frequency unprivileged users may request.
+	# TYPE node_rtc_max_user_frequency_hertz gauge
+	node_rtc_max_user_frequency_hertz{device="rtc0"} 64
+	node_rtc_max_user_frequency_hertz{device="rtc1"} 64
+	# HELP node_rtc_wakealarm_set Whether a wakeup alarm is scheduled.
+	# TYPE node_rtc_wakealarm_set gauge
+	node_rtc_wakealarm_set{device="rtc0"} 0
+	node_rtc_wakealarm_set{device="rtc1"} 1
+	# HELP node_rtc_wakealarm_timestamp_seconds Time of the scheduled wakeup alarm in unixtime.
+	# TYPE node_rtc_wakealarm_timestamp_seconds gauge
+	node_rtc_wakealarm_timestamp_seconds{device="rtc1"} 1.7600036e+09
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewRTCCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testRTCCollector{rc: c})
+
+	// The drift depends on the current time, only its presence is checked.
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase),
+		"node_rtc_hctosys", "node_rtc_max_user_frequency_hertz",
+		"node_rtc_wakealarm_set", "node_rtc_wakealarm_timestamp_seconds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := testutil.CollectAndCount(&testRTCCollector{rc: c}, "node_rtc_clock_drift_seconds"); n != 2 {
+		t.Errorf("want 2 clock drift metrics, got %d", n)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nosctp
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// sctpAssocStats holds the totals over all SCTP associations.
+type sctpAssocStats struct {
+	associations uint64
+	inStreams    uint64
+	outStreams   uint64
+}
+
+type sctpCollector struct {
+	currEstab       *prometheus.Desc
+	aborted         *prometheus.Desc
+	t3RtxExpireds   *prometheus.Desc
+	associations    *prometheus.Desc
+	inStreams       *prometheus.Desc
+	outStreams      *prometheus.Desc
+	remoteAddresses *prometheus.Desc
+	logger          *slog.Logger
+}
+
+func init() {
+	registerCollector("sctp", defaultDisabled, NewSCTPCollector)
+}
+
+// NewSCTPCollector returns a new Collector exposing SCTP statistics.
+func NewSCTPCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "sctp"
+
+	return &sctpCollector{
+		currEstab: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "curr_estab"),
+			"Number of SCTP associations in the ESTABLISHED, SHUTDOWN-RECEIVED or SHUTDOWN-PENDING state.",
+			nil, nil,
+		),
+		aborted: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "aborted_total"),
+			"Number of SCTP associations closed abortively (SctpAborteds).",
+			nil, nil,
+		),
+		t3RtxExpireds: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "t3_rtx_expirations_total"),
+			"Number of expirations of the SCTP T3-rtx retransmission timer.",
+			nil, nil,
+		),
+		associations: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "associations"),
+			"Number of SCTP associations, from /proc/net/sctp/assocs.",
+			nil, nil,
+		),
+		inStreams: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "in_streams"),
+			"Number of inbound streams over all SCTP associations.",
+			nil, nil,
+		),
+		outStreams: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "out_streams"),
+			"Number of outbound streams over all SCTP associations.",
+			nil, nil,
+		),
+		remoteAddresses: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "remote_addresses"),
+			"Number of remote transport addresses over all SCTP associations.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *sctpCollector) Update(ch chan<- prometheus.Metric) error {
+	// The files only exist once the sctp module is loaded.
+	snmp, err := os.ReadFile(procFilePath("net/sctp/snmp"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("SCTP statistics not found", "err", err)
+			return ErrNoData
+		}
+		return err
+	}
+
+	counters, err := parseSCTPSNMP(string(snmp))
+	if err != nil {
+		return fmt.Errorf("failed to parse /proc/net/sctp/snmp: %w", err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.currEstab, prometheus.GaugeValue, float64(counters["SctpCurrEstab"]))
+	ch <- prometheus.MustNewConstMetric(c.aborted, prometheus.CounterValue, float64(counters["SctpAborteds"]))
+	ch <- prometheus.MustNewConstMetric(c.t3RtxExpireds, prometheus.CounterValue, float64(counters["SctpT3RtxExpireds"]))
+
+	assocs, err := os.Open(procFilePath("net/sctp/assocs"))
+	if err != nil {
+		return err
+	}
+	defer assocs.Close()
+
+	stats, err := parseSCTPAssocs(assocs)
+	if err != nil {
+		return fmt.Errorf("failed to parse /proc/net/sctp/assocs: %w", err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.associations, prometheus.GaugeValue, float64(stats.associations))
+	ch <- prometheus.MustNewConstMetric(c.inStreams, prometheus.GaugeValue, float64(stats.inStreams))
+	ch <- prometheus.MustNewConstMetric(c.outStreams, prometheus.GaugeValue, float64(stats.outStreams))
+
+	remaddr, err := os.ReadFile(procFilePath("net/sctp/remaddr"))
+	if err != nil {
+		return err
+	}
+	// One line per remote address after the header.
+	lines := strings.Split(strings.TrimSpace(string(remaddr)), "\n")
+	ch <- prometheus.MustNewConstMetric(c.remoteAddresses, prometheus.GaugeValue, float64(len(lines)-1))
+
+	return nil
+}
+
+// parseSCTPSNMP parses /proc/net/sctp/snmp, which has one "name value" pair
+// per line.
+func parseSCTPSNMP(data string) (map[string]uint64, error) {
+	counters := make(map[string]uint64)
+	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("unexpected line %q", line)
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in line %q: %w", line, err)
+		}
+		counters[fields[0]] = v
+	}
+	return counters, nil
+}
+
+// parseSCTPAssocs parses /proc/net/sctp/assocs. Associations can have
+// several local and remote addresses, so the columns following the
+// "LADDRS <-> RADDRS" address lists are located from the end of the line.
+func parseSCTPAssocs(r io.Reader) (sctpAssocStats, error) {
+	var stats sctpAssocStats
+
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return stats, scanner.Err()
+	}
+	header := strings.Fields(scanner.Text())
+	raddrs := slices.Index(header, "RADDRS")
+	if raddrs < 0 {
+		return stats, fmt.Errorf("unexpected header %q", scanner.Text())
+	}
+	trailer := header[raddrs+1:]
+	ins, outs := slices.Index(trailer, "INS"), slices.Index(trailer, "OUTS")
+	if ins < 0 || outs < 0 {
+		return stats, fmt.Errorf("missing stream columns in header %q", scanner.Text())
+	}
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < len(header) {
+			return stats, fmt.Errorf("unexpected line %q", scanner.Text())
+		}
+		offset := len(fields) - len(trailer)
+		in, err := strconv.ParseUint(fields[offset+ins], 10, 64)
+		if err != nil {
+			return stats, fmt.Errorf("invalid inbound streams in line %q: %w", scanner.Text(), err)
+		}
+		out, err := strconv.ParseUint(fields[offset+outs], 10, 64)
+		if err != nil {
+			return stats, fmt.Errorf("invalid outbound streams in line %q: %w", scanner.Text(), err)
+		}
+		stats.associations++
+		stats.inStreams += in
+		stats.outStreams += out
+	}
+
+	return stats, scanner.Err()
+}
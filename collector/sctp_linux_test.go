@@ -0,0 +1,38 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nosctp
+
+package collector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSCTPAssocs(t *testing.T) {
+	const assocs = ` ASSOC     SOCK   STY SST ST HBKT ASSOC-ID TX_QUEUE RX_QUEUE UID INODE LPORT RPORT LADDRS <-> RADDRS HBINT INS OUTS MAXRT T1X T2X RTXC wmema wmemq sndbuf rcvbuf
+ffff8f2a4b1e8000 ffff8f2a4a0b6c80 2   1   3  1139    1        0        0       0 31548  3868  52114  10.0.0.1 <-> *10.0.0.2 	    7500    10    10   10    0    0        0        1        0   212992   212992
+ffff8f2a4b1ec000 ffff8f2a4a0b7680 2   1   3  2275    2        0        0       0 31560  2905  40212  10.0.0.1 192.168.0.1 <-> *10.0.0.3 192.168.0.3 	    7500    17    2   10    0    0        0        1        0   212992   212992
+`
+
+	stats, err := parseSCTPAssocs(strings.NewReader(assocs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := sctpAssocStats{associations: 2, inStreams: 27, outStreams: 12}
+	if stats != want {
+		t.Fatalf("want %+v, got %+v", want, stats)
+	}
+}
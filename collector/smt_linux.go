@@ -0,0 +1,112 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nosmt
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type smtCollector struct {
+	enabled       *prometheus.Desc
+	control       *prometheus.Desc
+	physicalCores *prometheus.Desc
+	logicalCores  *prometheus.Desc
+	logger        *slog.Logger
+}
+
+func init() {
+	registerCollector("smt", defaultDisabled, NewSMTCollector)
+}
+
+// NewSMTCollector returns a new Collector exposing simultaneous multithreading status.
+func NewSMTCollector(logger *slog.Logger) (Collector, error) {
+	return &smtCollector{
+		enabled: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cpu", "smt_enabled"),
+			"Whether simultaneous multithreading (SMT) is active.",
+			nil, nil,
+		),
+		control: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cpu", "smt_control_info"),
+			"SMT control setting from /sys/devices/system/cpu/smt/control.",
+			[]string{"control"}, nil,
+		),
+		physicalCores: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cpu", "physical_cores"),
+			"Number of physical CPU cores.",
+			nil, nil,
+		),
+		logicalCores: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cpu", "logical_cores"),
+			"Number of logical CPUs.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *smtCollector) Update(ch chan<- prometheus.Metric) error {
+	active, err := readUintFromFile(sysFilePath("devices/system/cpu/smt/active"))
+	switch {
+	case err == nil:
+		ch <- prometheus.MustNewConstMetric(c.enabled, prometheus.GaugeValue, float64(active))
+	case errors.Is(err, os.ErrNotExist):
+		c.logger.Debug("SMT active state not available")
+	default:
+		return fmt.Errorf("failed to read SMT active state: %w", err)
+	}
+
+	control, err := os.ReadFile(sysFilePath("devices/system/cpu/smt/control"))
+	switch {
+	case err == nil:
+		ch <- prometheus.MustNewConstMetric(c.control, prometheus.GaugeValue, 1, strings.TrimSpace(string(control)))
+	case errors.Is(err, os.ErrNotExist):
+		c.logger.Debug("SMT control not available")
+	default:
+		return fmt.Errorf("failed to read SMT control: %w", err)
+	}
+
+	siblingLists, err := filepath.Glob(sysFilePath("devices/system/cpu/cpu[0-9]*/topology/thread_siblings_list"))
+	if err != nil {
+		return err
+	}
+	if len(siblingLists) == 0 {
+		return nil
+	}
+
+	// All threads of a core share the same thread_siblings_list, so the
+	// number of distinct lists is the number of physical cores.
+	cores := make(map[string]struct{})
+	for _, path := range siblingLists {
+		siblings, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		cores[strings.TrimSpace(string(siblings))] = struct{}{}
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.physicalCores, prometheus.GaugeValue, float64(len(cores)))
+	ch <- prometheus.MustNewConstMetric(c.logicalCores, prometheus.GaugeValue, float64(len(siblingLists)))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nosmt
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testSMTCollector struct {
+	sc Collector
+}
+
+func (c testSMTCollector) Collect(ch chan<- prometheus.Metric) {
+	c.sc.Update(ch)
+}
+
+func (c testSMTCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestSMTStats(t *testing.T) {
+	testcase := `# HELP node_cpu_logical_cores Number of logical CPUs.
+	# TYPE node_cpu_logical_cores gauge
+	node_cpu_logical_cores 4
+	# HELP node_cpu_physical_cores Number of physical CPU cores.
+	# TYPE node_cpu_physical_cores gauge
+	node_cpu_physical_cores 2
+	# HELP node_cpu_smt_control_info SMT control setting from /sys/devices/system/cpu/smt/control.
+	# TYPE node_cpu_smt_control_info gauge
+	node_cpu_smt_control_info{control="on"} 1
+	# HELP node_cpu_smt_enabled Whether simultaneous multithreading (SMT) is active.
+	# TYPE node_cpu_smt_enabled gauge
+	node_cpu_smt_enabled 1
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewSMTCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testSMTCollector{sc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noresolved
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	resolvedDbusObject = "org.freedesktop.resolve1"
+	resolvedDbusPath   = "/org/freedesktop/resolve1"
+
+	// resolvedNoCache is SD_RESOLVED_NO_CACHE from systemd's resolved-def.h,
+	// it makes sure the probe is answered by the DNS server.
+	resolvedNoCache = 1 << 12
+)
+
+var resolvedProbeHostname = kingpin.Flag("collector.resolved.probe-hostname", "Hostname resolved via systemd-resolved on every scrape to measure the DNS round trip time, required by the resolved collector.").String()
+
+// resolvedDNSServer is the Manager.CurrentDNSServer property. Struct
+// elements must be public for the reflection magic of godbus to work.
+type resolvedDNSServer struct {
+	Ifindex int32
+	Family  int32
+	Address []byte
+}
+
+type resolvedCollector struct {
+	hostname string
+	rtt      *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("resolved", defaultDisabled, NewResolvedCollector)
+}
+
+// NewResolvedCollector returns a new Collector probing DNS resolution via
+// systemd-resolved. Every scrape sends a DNS query for the probe hostname to
+// the current DNS server, so there is no default hostname.
+func NewResolvedCollector(logger *slog.Logger) (Collector, error) {
+	if *resolvedProbeHostname == "" {
+		return nil, errors.New("--collector.resolved.probe-hostname must be set")
+	}
+
+	return &resolvedCollector{
+		hostname: *resolvedProbeHostname,
+		rtt: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "dns", "resolve_rtt_seconds"),
+			"Time systemd-resolved took to resolve the probe hostname, bypassing its cache.",
+			[]string{"server"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *resolvedCollector) Update(ch chan<- prometheus.Metric) error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer conn.Close()
+
+	object := conn.Object(resolvedDbusObject, resolvedDbusPath)
+
+	// The query is answered by the current DNS server, resolved only switches
+	// servers on failures.
+	var server string
+	v, err := object.GetProperty(resolvedDbusObject + ".Manager.CurrentDNSServer")
+	if err != nil {
+		c.logger.Debug("unable to get current DNS server", "err", err)
+	} else if server, err = resolvedServerAddress(v); err != nil {
+		c.logger.Debug("unexpected current DNS server", "value", v.String(), "err", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	// ResolveHostname(ifindex, name, family, flags), 0 means any interface
+	// and address family.
+	call := object.CallWithContext(ctx, resolvedDbusObject+".Manager.ResolveHostname", 0,
+		int32(0), c.hostname, int32(0), uint64(resolvedNoCache))
+	if call.Err != nil {
+		return fmt.Errorf("unable to resolve %q: %w", c.hostname, call.Err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.rtt, prometheus.GaugeValue, time.Since(start).Seconds(), server)
+
+	return nil
+}
+
+// resolvedServerAddress decodes the address of a Manager.CurrentDNSServer
+// property value. An empty address means there is no current server.
+func resolvedServerAddress(v dbus.Variant) (string, error) {
+	var server resolvedDNSServer
+	if err := dbus.Store([]any{v.Value()}, &server); err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(server.Address) == 0:
+		return "", nil
+	case server.Family == unix.AF_INET && len(server.Address) == net.IPv4len,
+		server.Family == unix.AF_INET6 && len(server.Address) == net.IPv6len:
+		return net.IP(server.Address).String(), nil
+	}
+	return "", fmt.Errorf("invalid address of length %d for family %d", len(server.Address), server.Family)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noresolved
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"golang.org/x/sys/unix"
+)
+
+func TestResolvedServerAddress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		value   any
+		want    string
+		wantErr bool
+	}{
+		"ipv4": {
+			value: []any{int32(2), int32(unix.AF_INET), []byte{192, 0, 2, 53}},
+			want:  "192.0.2.53",
+		},
+		"ipv6": {
+			value: []any{int32(0), int32(unix.AF_INET6), []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x53}},
+			want:  "2001:db8::53",
+		},
+		"no server": {
+			value: []any{int32(0), int32(0), []byte{}},
+			want:  "",
+		},
+		"family mismatch": {
+			value:   []any{int32(0), int32(unix.AF_INET6), []byte{192, 0, 2, 53}},
+			wantErr: true,
+		},
+		"wrong signature": {
+			value:   "192.0.2.53",
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolvedServerAddress(dbus.MakeVariant(tc.value))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("want error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("want server %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -17,19 +17,45 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/alecthomas/kingpin/v2"
 	"github.com/coreos/go-systemd/v22/dbus"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+var (
+	systemdServicesUnitInclude     = kingpin.Flag("collector.systemdservices.unit-include", "Regexp of service units to include. Units must both match include and not match exclude to be included.").Default(".+").String()
+	systemdServicesUnitExclude     = kingpin.Flag("collector.systemdservices.unit-exclude", "Regexp of service units to exclude. Units must both match include and not match exclude to be included.").Default("").String()
+	systemdServicesFragmentMetrics = kingpin.Flag("collector.systemdservices.enable-fragment-metrics", "Enables the service unit file modification time metric. Requires one additional D-Bus call per service.").Default("false").Bool()
+	systemdServicesResourceMetrics = kingpin.Flag("collector.systemdservices.enable-resource-metrics", "Enables service resource accounting metrics (CPU, memory, tasks, start time). Requires one additional D-Bus call per service.").Default("true").Bool()
+)
+
+// systemdServiceResource describes a resource accounting property of the
+// systemd Service D-Bus interface.
+type systemdServiceResource struct {
+	property  string
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	scale     float64
+	// Timestamps are zero if the event never happened.
+	skipZero bool
+}
+
 type systemdServicesCollector struct {
 	serviceInfo         *prometheus.Desc
 	serviceState        *prometheus.Desc
 	serviceSubState     *prometheus.Desc
 	serviceLoadState    *prometheus.Desc
 	serviceRestartTotal *prometheus.Desc
+	fragmentMtime       *prometheus.Desc
+	resources           []systemdServiceResource
+	unitIncludePattern  *regexp.Regexp
+	unitExcludePattern  *regexp.Regexp
 	logger              *slog.Logger
 	conn                *dbus.Conn
 }
@@ -39,6 +65,17 @@ func init() {
 }
 
 func NewSystemdServicesCollector(logger *slog.Logger) (Collector, error) {
+	unitIncludePattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *systemdServicesUnitInclude))
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit include pattern: %w", err)
+	}
+	unitExcludePattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *systemdServicesUnitExclude))
+	if err != nil {
+		return nil, fmt.Errorf("invalid unit exclude pattern: %w", err)
+	}
+	logger.Info("Parsed flag --collector.systemdservices.unit-include", "flag", *systemdServicesUnitInclude)
+	logger.Info("Parsed flag --collector.systemdservices.unit-exclude", "flag", *systemdServicesUnitExclude)
+
 	conn, err := newSystemdDbusConn()
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get dbus connection: %w", err)
@@ -75,8 +112,17 @@ func NewSystemdServicesCollector(logger *slog.Logger) (Collector, error) {
 			[]string{"name"},
 			nil,
 		),
-		logger: logger,
-		conn:   conn,
+		fragmentMtime: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "systemd_service", "fragment_mtime_seconds"),
+			"Modification time of the service unit file (systemd Unit FragmentPath) in unixtime.",
+			[]string{"name", "path"},
+			nil,
+		),
+		resources:          newSystemdServiceResources(),
+		unitIncludePattern: unitIncludePattern,
+		unitExcludePattern: unitExcludePattern,
+		logger:             logger,
+		conn:               conn,
 	}, nil
 }
 
@@ -86,11 +132,8 @@ func (c *systemdServicesCollector) Update(ch chan<- prometheus.Metric) error {
 		return fmt.Errorf("couldn't get units: %w", err)
 	}
 
-	for _, unit := range units {
-		if !strings.HasSuffix(unit.Name, ".service") {
-			continue
-		}
-
+	// Filter before collecting, every unit costs several D-Bus calls.
+	for _, unit := range c.filterUnits(units) {
 		if err := c.collectServiceMetrics(c.conn, ch, unit); err != nil {
 			c.logger.Debug("failed to collect metrics for unit", "unit", unit.Name, "error", err)
 			continue
@@ -100,6 +143,23 @@ func (c *systemdServicesCollector) Update(ch chan<- prometheus.Metric) error {
 	return nil
 }
 
+// filterUnits returns the service units that match the include pattern and
+// don't match the exclude pattern.
+func (c *systemdServicesCollector) filterUnits(units []dbus.UnitStatus) []dbus.UnitStatus {
+	filtered := make([]dbus.UnitStatus, 0, len(units))
+	for _, unit := range units {
+		if !strings.HasSuffix(unit.Name, ".service") {
+			continue
+		}
+		if !c.unitIncludePattern.MatchString(unit.Name) || c.unitExcludePattern.MatchString(unit.Name) {
+			c.logger.Debug("Ignoring unit", "unit", unit.Name)
+			continue
+		}
+		filtered = append(filtered, unit)
+	}
+	return filtered
+}
+
 func (c *systemdServicesCollector) getAllUnits(conn *dbus.Conn) ([]dbus.UnitStatus, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -174,9 +234,125 @@ func (c *systemdServicesCollector) collectServiceMetrics(conn *dbus.Conn, ch cha
 		}
 	}
 
+	if *systemdServicesResourceMetrics {
+		c.collectResourceMetrics(conn, ch, unit)
+	}
+	if *systemdServicesFragmentMetrics {
+		c.collectFragmentMetrics(conn, ch, unit)
+	}
+
 	return nil
 }
 
+func newSystemdServiceResources() []systemdServiceResource {
+	return []systemdServiceResource{
+		{
+			property: "CPUUsageNSec",
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "systemd_service", "cpu_usage_seconds_total"),
+				"CPU time consumed by the service in seconds (systemd Service CPUUsageNSec), requires CPUAccounting.",
+				[]string{"name"},
+				nil,
+			),
+			valueType: prometheus.CounterValue,
+			scale:     1e-9,
+		},
+		{
+			property: "MemoryCurrent",
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "systemd_service", "memory_current_bytes"),
+				"Memory used by the service in bytes (systemd Service MemoryCurrent), requires MemoryAccounting.",
+				[]string{"name"},
+				nil,
+			),
+			valueType: prometheus.GaugeValue,
+			scale:     1,
+		},
+		{
+			property: "TasksCurrent",
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "systemd_service", "tasks_current"),
+				"Number of tasks of the service (systemd Service TasksCurrent), requires TasksAccounting.",
+				[]string{"name"},
+				nil,
+			),
+			valueType: prometheus.GaugeValue,
+			scale:     1,
+		},
+		{
+			property: "ExecMainStartTimestamp",
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "systemd_service", "start_time_seconds"),
+				"Start time of the main process of the service in unixtime (systemd Service ExecMainStartTimestamp).",
+				[]string{"name"},
+				nil,
+			),
+			valueType: prometheus.GaugeValue,
+			scale:     1e-6,
+			skipZero:  true,
+		},
+	}
+}
+
+func (c *systemdServicesCollector) collectResourceMetrics(conn *dbus.Conn, ch chan<- prometheus.Metric, unit dbus.UnitStatus) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	properties, err := conn.GetUnitTypePropertiesContext(ctx, unit.Name, "Service")
+	if err != nil {
+		c.logger.Debug("couldn't get unit properties", "unit", unit.Name, "err", err)
+		return
+	}
+	c.updateResourceMetrics(ch, unit.Name, properties)
+}
+
+// updateResourceMetrics converts the Service properties of a unit into
+// resource accounting metrics.
+func (c *systemdServicesCollector) updateResourceMetrics(ch chan<- prometheus.Metric, name string, properties map[string]any) {
+	for _, r := range c.resources {
+		property, ok := properties[r.property]
+		if !ok {
+			continue
+		}
+		value, ok := systemdAccountingValue(property)
+		if !ok || (r.skipZero && value == 0) {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(r.desc, r.valueType, value*r.scale, name)
+	}
+}
+
+// systemdAccountingValue converts a resource accounting property. systemd
+// reports ^uint64(0) if accounting is disabled or the value is unavailable.
+func systemdAccountingValue(v any) (float64, bool) {
+	if n, ok := v.(uint64); ok && n == math.MaxUint64 {
+		return 0, false
+	}
+	return dbusNumericToFloat64(v)
+}
+
+func (c *systemdServicesCollector) collectFragmentMetrics(conn *dbus.Conn, ch chan<- prometheus.Metric, unit dbus.UnitStatus) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	fragmentPath, err := conn.GetUnitPropertyContext(ctx, unit.Name, "FragmentPath")
+	if err != nil {
+		c.logger.Debug("couldn't get unit FragmentPath", "unit", unit.Name, "err", err)
+		return
+	}
+	// Units generated at runtime, e.g. transient units, have no unit file.
+	path, ok := fragmentPath.Value.Value().(string)
+	if !ok || path == "" {
+		return
+	}
+	info, err := os.Stat(rootfsFilePath(path))
+	if err != nil {
+		c.logger.Debug("couldn't stat unit file", "unit", unit.Name, "path", path, "err", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(
+		c.fragmentMtime, prometheus.GaugeValue,
+		float64(info.ModTime().UnixNano())/1e9, unit.Name, path)
+}
+
 // parseSystemdState converts systemd state string to numeric value
 func parseSystemdState(state string) float64 {
 	switch strings.ToLower(state) {
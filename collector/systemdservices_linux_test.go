@@ -0,0 +1,147 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nosystemdservices
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testSystemdServiceResourceCollector struct {
+	c          *systemdServicesCollector
+	properties map[string]any
+}
+
+func (c testSystemdServiceResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	c.c.updateResourceMetrics(ch, "foo.service", c.properties)
+}
+
+func (c testSystemdServiceResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestSystemdAccountingValue(t *testing.T) {
+	for _, tc := range []struct {
+		in   any
+		want float64
+		ok   bool
+	}{
+		{uint64(math.MaxUint64), 0, false},
+		{uint64(0), 0, true},
+		{uint64(4096), 4096, true},
+		{uint32(3), 3, true},
+		{"unexpected", 0, false},
+	} {
+		got, ok := systemdAccountingValue(tc.in)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("systemdAccountingValue(%v): want %v, %v, got %v, %v", tc.in, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestSystemdServiceResourceTypes(t *testing.T) {
+	want := map[string]prometheus.ValueType{
+		"CPUUsageNSec":           prometheus.CounterValue,
+		"MemoryCurrent":          prometheus.GaugeValue,
+		"TasksCurrent":           prometheus.GaugeValue,
+		"ExecMainStartTimestamp": prometheus.GaugeValue,
+	}
+
+	resources := newSystemdServiceResources()
+	if len(resources) != len(want) {
+		t.Fatalf("want %d resources, got %d", len(want), len(resources))
+	}
+	for _, r := range resources {
+		if r.valueType != want[r.property] {
+			t.Errorf("%s: want value type %v, got %v", r.property, want[r.property], r.valueType)
+		}
+	}
+}
+
+func TestSystemdServiceResourceMetrics(t *testing.T) {
+	c := &systemdServicesCollector{
+		resources: newSystemdServiceResources(),
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	// MemoryCurrent is unavailable without MemoryAccounting and the main
+	// process was never started.
+	properties := map[string]any{
+		"CPUUsageNSec":           uint64(1500000000),
+		"MemoryCurrent":          uint64(math.MaxUint64),
+		"TasksCurrent":           uint64(3),
+		"ExecMainStartTimestamp": uint64(0),
+		"Type":                   "simple",
+	}
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(testSystemdServiceResourceCollector{c: c, properties: properties})
+
+	want := `# HELP node_systemd_service_cpu_usage_seconds_total CPU time consumed by the service in seconds (systemd Service CPUUsageNSec), requires CPUAccounting.
+# TYPE node_systemd_service_cpu_usage_seconds_total counter
+node_systemd_service_cpu_usage_seconds_total{name="foo.service"} 1.5
+# HELP node_systemd_service_tasks_current Number of tasks of the service (systemd Service TasksCurrent), requires TasksAccounting.
+# TYPE node_systemd_service_tasks_current gauge
+node_systemd_service_tasks_current{name="foo.service"} 3
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want)); err != nil {
+		t.Fatal(err)
+	}
+
+	properties["MemoryCurrent"] = uint64(4096)
+	properties["ExecMainStartTimestamp"] = uint64(1700000000500000)
+	want += `# HELP node_systemd_service_memory_current_bytes Memory used by the service in bytes (systemd Service MemoryCurrent), requires MemoryAccounting.
+# TYPE node_systemd_service_memory_current_bytes gauge
+node_systemd_service_memory_current_bytes{name="foo.service"} 4096
+# HELP node_systemd_service_start_time_seconds Start time of the main process of the service in unixtime (systemd Service ExecMainStartTimestamp).
+# TYPE node_systemd_service_start_time_seconds gauge
+node_systemd_service_start_time_seconds{name="foo.service"} 1.7000000005e+09
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSystemdServicesFilterUnits(t *testing.T) {
+	c := &systemdServicesCollector{
+		unitIncludePattern: regexp.MustCompile("^(?:(foo|bar).*)$"),
+		unitExcludePattern: regexp.MustCompile("^(?:bar-debug.service)$"),
+		logger:             slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	units := []dbus.UnitStatus{
+		{Name: "foo.service"},
+		{Name: "foo.socket"},
+		{Name: "bar.service"},
+		{Name: "bar-debug.service"},
+		{Name: "baz.service"},
+	}
+
+	var names []string
+	for _, unit := range c.filterUnits(units) {
+		names = append(names, unit.Name)
+	}
+	if want := []string{"foo.service", "bar.service"}; !slices.Equal(names, want) {
+		t.Errorf("want units %v, got %v", want, names)
+	}
+}
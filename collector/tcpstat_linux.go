@@ -16,16 +16,21 @@
 package collector
 
 import (
+	"encoding/binary"
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"syscall"
 	"unsafe"
 
+	"github.com/alecthomas/kingpin/v2"
 	"github.com/mdlayher/netlink"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+var tcpstatListenBacklog = kingpin.Flag("collector.tcpstat.listen-backlog", "Expose the accept queue of listening sockets per local port.").Default("false").Bool()
+
 type tcpConnectionState int
 
 const (
@@ -58,8 +63,16 @@ const (
 )
 
 type tcpStatCollector struct {
-	desc   typedDesc
-	logger *slog.Logger
+	desc             typedDesc
+	listenBacklog    typedDesc
+	listenBacklogMax typedDesc
+	logger           *slog.Logger
+}
+
+// tcpListenBacklog is the accept queue of the listening sockets of a port.
+type tcpListenBacklog struct {
+	current float64
+	max     float64
 }
 
 func init() {
@@ -74,6 +87,16 @@ func NewTCPStatCollector(logger *slog.Logger) (Collector, error) {
 			"Number of connection states.",
 			[]string{"state"}, nil,
 		), prometheus.GaugeValue},
+		listenBacklog: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "tcp", "listen_socket_backlog"),
+			"Number of connections waiting in the accept queue of listening sockets.",
+			[]string{"local_port"}, nil,
+		), prometheus.GaugeValue},
+		listenBacklogMax: typedDesc{prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "tcp", "listen_socket_backlog_max"),
+			"Maximum length of the accept queue of listening sockets, as passed to listen() and capped by net.core.somaxconn.",
+			[]string{"local_port"}, nil,
+		), prometheus.GaugeValue},
 		logger: logger,
 	}, nil
 }
@@ -128,31 +151,48 @@ func parseInetDiagMsg(b []byte) *InetDiagMsg {
 }
 
 func (c *tcpStatCollector) Update(ch chan<- prometheus.Metric) error {
-	tcpStats, err := getTCPStats(syscall.AF_INET)
-	if err != nil {
-		return fmt.Errorf("couldn't get tcpstats: %w", err)
-	}
-
+	families := []uint8{syscall.AF_INET}
 	// if enabled ipv6 system
 	if _, hasIPv6 := os.Stat(procFilePath("net/tcp6")); hasIPv6 == nil {
-		tcp6Stats, err := getTCPStats(syscall.AF_INET6)
+		families = append(families, syscall.AF_INET6)
+	}
+
+	tcpStats := map[tcpConnectionState]float64{}
+	backlogs := map[uint16]*tcpListenBacklog{}
+	for _, family := range families {
+		msgs, err := dumpTCPSockets(family)
 		if err != nil {
-			return fmt.Errorf("couldn't get tcp6stats: %w", err)
+			return fmt.Errorf("couldn't get tcpstats: %w", err)
 		}
 
-		for st, value := range tcp6Stats {
+		stats, err := parseTCPStats(msgs)
+		if err != nil {
+			return fmt.Errorf("couldn't get tcpstats: %w", err)
+		}
+		for st, value := range stats {
 			tcpStats[st] += value
 		}
+
+		if *tcpstatListenBacklog {
+			parseTCPListenBacklog(msgs, backlogs)
+		}
 	}
 
 	for st, value := range tcpStats {
 		ch <- c.desc.mustNewConstMetric(value, st.String())
 	}
 
+	if *tcpstatListenBacklog {
+		for port, b := range backlogs {
+			ch <- c.listenBacklog.mustNewConstMetric(b.current, strconv.Itoa(int(port)))
+			ch <- c.listenBacklogMax.mustNewConstMetric(b.max, strconv.Itoa(int(port)))
+		}
+	}
+
 	return nil
 }
 
-func getTCPStats(family uint8) (map[tcpConnectionState]float64, error) {
+func dumpTCPSockets(family uint8) ([]netlink.Message, error) {
 	const TCPFAll = 0xFFF
 	const InetDiagInfo = 2
 	const SockDiagByFamily = 20
@@ -176,12 +216,7 @@ func getTCPStats(family uint8) (map[tcpConnectionState]float64, error) {
 		}).Serialize(),
 	}
 
-	messages, err := conn.Execute(msg)
-	if err != nil {
-		return nil, err
-	}
-
-	return parseTCPStats(messages)
+	return conn.Execute(msg)
 }
 
 func parseTCPStats(msgs []netlink.Message) (map[tcpConnectionState]float64, error) {
@@ -198,6 +233,27 @@ func parseTCPStats(msgs []netlink.Message) (map[tcpConnectionState]float64, erro
 	return tcpStats, nil
 }
 
+// parseTCPListenBacklog adds the accept queues of listening sockets to
+// backlogs. For these sockets the kernel reports the current length of the
+// accept queue as RQueue and its maximum length as WQueue.
+func parseTCPListenBacklog(msgs []netlink.Message, backlogs map[uint16]*tcpListenBacklog) {
+	for _, m := range msgs {
+		msg := parseInetDiagMsg(m.Data)
+		if tcpConnectionState(msg.State) != tcpListen {
+			continue
+		}
+
+		port := binary.BigEndian.Uint16(msg.ID.SourcePort[:])
+		b, ok := backlogs[port]
+		if !ok {
+			b = &tcpListenBacklog{}
+			backlogs[port] = b
+		}
+		b.current += float64(msg.RQueue)
+		b.max += float64(msg.WQueue)
+	}
+}
+
 func (st tcpConnectionState) String() string {
 	switch st {
 	case tcpEstablished:
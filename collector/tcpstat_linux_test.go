@@ -86,3 +86,66 @@ func Test_parseTCPStats(t *testing.T) {
 	}
 
 }
+
+func Test_parseTCPListenBacklog(t *testing.T) {
+	encode := func(m InetDiagMsg) []byte {
+		var buf bytes.Buffer
+		err := binary.Write(&buf, binary.NativeEndian, m)
+		if err != nil {
+			panic(err)
+		}
+		return buf.Bytes()
+	}
+
+	msg := []netlink.Message{
+		{
+			Data: encode(InetDiagMsg{
+				Family: syscall.AF_INET,
+				State:  uint8(tcpEstablished),
+				ID:     InetDiagSockID{SourcePort: [2]byte{0x00, 0x16}},
+				RQueue: 11,
+				WQueue: 21,
+			}),
+		},
+		{
+			Data: encode(InetDiagMsg{
+				Family: syscall.AF_INET,
+				State:  uint8(tcpListen),
+				ID:     InetDiagSockID{SourcePort: [2]byte{0x00, 0x16}},
+				RQueue: 3,
+				WQueue: 128,
+			}),
+		},
+		{
+			Data: encode(InetDiagMsg{
+				Family: syscall.AF_INET6,
+				State:  uint8(tcpListen),
+				ID:     InetDiagSockID{SourcePort: [2]byte{0x00, 0x16}},
+				RQueue: 1,
+				WQueue: 128,
+			}),
+		},
+		{
+			Data: encode(InetDiagMsg{
+				Family: syscall.AF_INET,
+				State:  uint8(tcpListen),
+				ID:     InetDiagSockID{SourcePort: [2]byte{0x23, 0x8c}},
+				RQueue: 0,
+				WQueue: 4096,
+			}),
+		},
+	}
+
+	backlogs := map[uint16]*tcpListenBacklog{}
+	parseTCPListenBacklog(msg, backlogs)
+
+	if want, got := 2, len(backlogs); want != got {
+		t.Fatalf("want %d listening ports, got %d", want, got)
+	}
+	if want, got := (tcpListenBacklog{current: 4, max: 256}), *backlogs[22]; want != got {
+		t.Errorf("want backlog of port 22 %+v, got %+v", want, got)
+	}
+	if want, got := (tcpListenBacklog{current: 0, max: 4096}), *backlogs[9100]; want != got {
+		t.Errorf("want backlog of port 9100 %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notracefs
+
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// tracefsStats describes the fields of per_cpu/cpu*/stats.
+var tracefsStats = map[string]struct {
+	name      string
+	help      string
+	valueType prometheus.ValueType
+}{
+	"entries":        {"ring_buffer_entries", "Number of events currently in the ring buffer.", prometheus.GaugeValue},
+	"overrun":        {"ring_buffer_overrun_total", "Number of events lost because the ring buffer was full.", prometheus.CounterValue},
+	"commit overrun": {"ring_buffer_commit_overrun_total", "Number of events lost because of nested writers wrapping the buffer.", prometheus.CounterValue},
+	"bytes":          {"ring_buffer_bytes", "Number of bytes used by events in the ring buffer.", prometheus.GaugeValue},
+	"dropped events": {"ring_buffer_dropped_events_total", "Number of events dropped because the ring buffer was full in non-overwrite mode.", prometheus.CounterValue},
+	"read events":    {"ring_buffer_read_events_total", "Number of events read from the ring buffer.", prometheus.CounterValue},
+}
+
+type tracefsCollector struct {
+	descs          map[string]*prometheus.Desc
+	oldestEventAge *prometheus.Desc
+	logger         *slog.Logger
+}
+
+func init() {
+	registerCollector("tracefs", defaultDisabled, NewTracefsCollector)
+}
+
+// NewTracefsCollector returns a new Collector exposing tracing ring buffer statistics.
+func NewTracefsCollector(logger *slog.Logger) (Collector, error) {
+	descs := make(map[string]*prometheus.Desc, len(tracefsStats))
+	for key, stat := range tracefsStats {
+		descs[key] = prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "tracefs", stat.name),
+			stat.help,
+			[]string{"cpu"}, nil,
+		)
+	}
+
+	return &tracefsCollector{
+		descs: descs,
+		oldestEventAge: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "tracefs", "ring_buffer_oldest_event_age_seconds"),
+			"Age of the oldest event in the ring buffer, measured with the trace clock.",
+			[]string{"cpu"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *tracefsCollector) Update(ch chan<- prometheus.Metric) error {
+	// tracefs is mounted at /sys/kernel/tracing since Linux 4.1, older
+	// setups only provide it below debugfs.
+	var statFiles []string
+	for _, dir := range []string{"kernel/tracing", "kernel/debug/tracing"} {
+		files, err := filepath.Glob(sysFilePath(filepath.Join(dir, "per_cpu/cpu[0-9]*/stats")))
+		if err != nil {
+			return err
+		}
+		if len(files) > 0 {
+			statFiles = files
+			break
+		}
+	}
+	if len(statFiles) == 0 {
+		c.logger.Debug("tracefs not mounted or not accessible")
+		return ErrNoData
+	}
+
+	for _, file := range statFiles {
+		cpu := strings.TrimPrefix(filepath.Base(filepath.Dir(file)), "cpu")
+		stats, err := parseTracefsStats(file)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		for key, value := range stats {
+			desc, ok := c.descs[key]
+			if !ok {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(desc, tracefsStats[key].valueType, value, cpu)
+		}
+		// The timestamps are trace clock values, by default the time since
+		// boot, the oldest event timestamp is 0 for an empty buffer.
+		oldest, ok := stats["oldest event ts"]
+		if now, hasNow := stats["now ts"]; ok && hasNow && oldest > 0 {
+			ch <- prometheus.MustNewConstMetric(c.oldestEventAge, prometheus.GaugeValue, now-oldest, cpu)
+		}
+	}
+
+	return nil
+}
+
+func parseTracefsStats(path string) (map[string]float64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stats := make(map[string]float64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", key, err)
+		}
+		stats[key] = v
+	}
+
+	return stats, scanner.Err()
+}
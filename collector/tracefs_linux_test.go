@@ -0,0 +1,83 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notracefs
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testTracefsCollector struct {
+	tc Collector
+}
+
+func (c testTracefsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.tc.Update(ch)
+}
+
+func (c testTracefsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestTracefsStats(t *testing.T) {
+	testcase := `# HELP node_tracefs_ring_buffer_bytes Number of bytes used by events in the ring buffer.
+	# TYPE node_tracefs_ring_buffer_bytes gauge
+	node_tracefs_ring_buffer_bytes{cpu="0"} 1024
+	node_tracefs_ring_buffer_bytes{cpu="1"} 0
+	# HELP node_tracefs_ring_buffer_commit_overrun_total Number of events lost because of nested writers wrapping the buffer.
+	# TYPE node_tracefs_ring_buffer_commit_overrun_total counter
+	node_tracefs_ring_buffer_commit_overrun_total{cpu="0"} 0
+	node_tracefs_ring_buffer_commit_overrun_total{cpu="1"} 1
+	# HELP node_tracefs_ring_buffer_dropped_events_total Number of events dropped because the ring buffer was full in non-overwrite mode.
+	# TYPE node_tracefs_ring_buffer_dropped_events_total counter
+	node_tracefs_ring_buffer_dropped_events_total{cpu="0"} 0
+	node_tracefs_ring_buffer_dropped_events_total{cpu="1"} 7
+	# HELP node_tracefs_ring_buffer_entries Number of events currently in the ring buffer.
+	# TYPE node_tracefs_ring_buffer_entries gauge
+	node_tracefs_ring_buffer_entries{cpu="0"} 12
+	node_tracefs_ring_buffer_entries{cpu="1"} 0
+	# HELP node_tracefs_ring_buffer_oldest_event_age_seconds Age of the oldest event in the ring buffer, measured with the trace clock.
+	# TYPE node_tracefs_ring_buffer_oldest_event_age_seconds gauge
+	node_tracefs_ring_buffer_oldest_event_age_seconds{cpu="0"} 65.5
+	# HELP node_tracefs_ring_buffer_overrun_total Number of events lost because the ring buffer was full.
+	# TYPE node_tracefs_ring_buffer_overrun_total counter
+	node_tracefs_ring_buffer_overrun_total{cpu="0"} 3
+	node_tracefs_ring_buffer_overrun_total{cpu="1"} 0
+	# HELP node_tracefs_ring_buffer_read_events_total Number of events read from the ring buffer.
+	# TYPE node_tracefs_ring_buffer_read_events_total counter
+	node_tracefs_ring_buffer_read_events_total{cpu="0"} 100
+	node_tracefs_ring_buffer_read_events_total{cpu="1"} 42
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewTracefsCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testTracefsCollector{tc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notxqueue
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type txQueueCollector struct {
+	inflight *prometheus.Desc
+	limit    *prometheus.Desc
+	limitMax *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("txqueue", defaultDisabled, NewTxQueueCollector)
+}
+
+// NewTxQueueCollector returns a new Collector exposing the Byte Queue Limits
+// (BQL) of network transmit queues.
+func NewTxQueueCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "network"
+	labels := []string{"device", "queue"}
+
+	return &txQueueCollector{
+		inflight: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "transmit_queue_bql_inflight_bytes"),
+			"Number of bytes queued to the device and not yet completed.",
+			labels, nil,
+		),
+		limit: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "transmit_queue_bql_limit_bytes"),
+			"Current dynamic limit of bytes that may be queued to the device.",
+			labels, nil,
+		),
+		limitMax: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "transmit_queue_bql_limit_max_bytes"),
+			"Configured maximum of the dynamic byte queue limit.",
+			labels, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *txQueueCollector) Update(ch chan<- prometheus.Metric) error {
+	// Only drivers supporting BQL have byte_queue_limits, e.g. not virtual devices.
+	queues, err := filepath.Glob(sysFilePath("class/net/*/queues/tx-*/byte_queue_limits"))
+	if err != nil {
+		return err
+	}
+	if len(queues) == 0 {
+		c.logger.Debug("no network devices with byte queue limits")
+		return ErrNoData
+	}
+
+	for _, dir := range queues {
+		queueDir := filepath.Dir(dir)
+		device := filepath.Base(filepath.Dir(filepath.Dir(queueDir)))
+		queue := strings.TrimPrefix(filepath.Base(queueDir), "tx-")
+
+		for _, f := range []struct {
+			file string
+			desc *prometheus.Desc
+		}{
+			{"inflight", c.inflight},
+			{"limit", c.limit},
+			{"limit_max", c.limitMax},
+		} {
+			value, err := readUintFromFile(filepath.Join(dir, f.file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					// The device was removed in the meantime.
+					continue
+				}
+				return err
+			}
+			ch <- prometheus.MustNewConstMetric(f.desc, prometheus.GaugeValue, float64(value), device, queue)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notxqueue
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testTxQueueCollector struct {
+	tc Collector
+}
+
+func (c testTxQueueCollector) Collect(ch chan<- prometheus.Metric) {
+	c.tc.Update(ch)
+}
+
+func (c testTxQueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestTxQueueStats(t *testing.T) {
+	testcase := `# HELP node_network_transmit_queue_bql_inflight_bytes Number of bytes queued to the device and not yet completed.
+	# TYPE node_network_transmit_queue_bql_inflight_bytes gauge
+	node_network_transmit_queue_bql_inflight_bytes{device="eth0",queue="0"} 0
+	node_network_transmit_queue_bql_inflight_bytes{device="eth0",queue="1"} 3028
+	# HELP node_network_transmit_queue_bql_limit_bytes Current dynamic limit of bytes that may be queued to the device.
+	# TYPE node_network_transmit_queue_bql_limit_bytes gauge
+	node_network_transmit_queue_bql_limit_bytes{device="eth0",queue="0"} 15140
+	node_network_transmit_queue_bql_limit_bytes{device="eth0",queue="1"} 30280
+	# HELP node_network_transmit_queue_bql_limit_max_bytes Configured maximum of the dynamic byte queue limit.
+	# TYPE node_network_transmit_queue_bql_limit_max_bytes gauge
+	node_network_transmit_queue_bql_limit_max_bytes{device="eth0",queue="0"} 1.879048192e+09
+	node_network_transmit_queue_bql_limit_max_bytes{device="eth0",queue="1"} 1.879048192e+09
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewTxQueueCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testTxQueueCollector{tc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notypec
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type typecCollector struct {
+	info      *prometheus.Desc
+	powerRole *prometheus.Desc
+	voltage   *prometheus.Desc
+	current   *prometheus.Desc
+	logger    *slog.Logger
+}
+
+func init() {
+	registerCollector("typec", defaultDisabled, NewTypecCollector)
+}
+
+// NewTypecCollector returns a new Collector exposing the USB Power Delivery
+// state of USB Type-C ports.
+func NewTypecCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "typec_port"
+
+	return &typecCollector{
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "info"),
+			"Information about the USB Type-C port.",
+			[]string{"port", "data_role", "preferred_role", "usb_power_delivery_revision"}, nil,
+		),
+		powerRole: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "power_role"),
+			"Current power role of the USB Type-C port.",
+			[]string{"port", "role"}, nil,
+		),
+		voltage: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "voltage_volts"),
+			"Voltage of the USB Power Delivery contract.",
+			[]string{"port", "power_supply"}, nil,
+		),
+		current: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "current_amps"),
+			"Current of the USB Power Delivery contract.",
+			[]string{"port", "power_supply"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *typecCollector) Update(ch chan<- prometheus.Metric) error {
+	// Partner and cable devices (port0-partner, port0-cable) live in the
+	// same class directory.
+	ports, err := filepath.Glob(sysFilePath("class/typec/port[0-9]*"))
+	if err != nil {
+		return err
+	}
+	var found bool
+	for _, dir := range ports {
+		port := filepath.Base(dir)
+		if strings.Contains(port, "-") {
+			continue
+		}
+		found = true
+
+		roles, err := os.ReadFile(filepath.Join(dir, "power_role"))
+		if err != nil {
+			return fmt.Errorf("failed to read power role of %s: %w", port, err)
+		}
+		for _, role := range strings.Fields(string(roles)) {
+			active := 0.0
+			if strings.HasPrefix(role, "[") {
+				active = 1
+			}
+			ch <- prometheus.MustNewConstMetric(c.powerRole, prometheus.GaugeValue, active, port, strings.Trim(role, "[]"))
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1,
+			port,
+			typecSelectedValue(filepath.Join(dir, "data_role")),
+			typecSelectedValue(filepath.Join(dir, "preferred_role")),
+			typecSelectedValue(filepath.Join(dir, "usb_power_delivery_revision")),
+		)
+
+		for _, supply := range typecPowerSupplies(dir) {
+			name := filepath.Base(supply)
+			for _, m := range []struct {
+				file string
+				desc *prometheus.Desc
+			}{
+				{"voltage_now", c.voltage},
+				{"current_now", c.current},
+			} {
+				// Values are in microvolts and microamps, power supply
+				// currents are signed.
+				data, err := os.ReadFile(filepath.Join(supply, m.file))
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						continue
+					}
+					return fmt.Errorf("failed to read %s of %s: %w", m.file, name, err)
+				}
+				value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid %s of %s: %w", m.file, name, err)
+				}
+				ch <- prometheus.MustNewConstMetric(m.desc, prometheus.GaugeValue, float64(value)/1e6, port, name)
+			}
+		}
+	}
+	if !found {
+		c.logger.Debug("no USB Type-C ports found")
+		return ErrNoData
+	}
+
+	return nil
+}
+
+// typecPowerSupplies returns the power supplies of a Type-C port. Depending on
+// the driver they are registered below the port, below its partner or, for
+// UCSI, below the connector device as ucsi-source-psy-<device><connector>
+// with connectors counted from 1.
+func typecPowerSupplies(dir string) []string {
+	var supplies []string
+	for _, d := range []string{dir, dir + "-partner"} {
+		if matches, err := filepath.Glob(filepath.Join(d, "power_supply", "*")); err == nil {
+			supplies = append(supplies, matches...)
+		}
+	}
+
+	device, err := filepath.EvalSymlinks(filepath.Join(dir, "device"))
+	if err != nil {
+		return supplies
+	}
+	index, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "port"))
+	if err != nil {
+		return supplies
+	}
+	ucsi := filepath.Join(device, "power_supply", fmt.Sprintf("ucsi-source-psy-%s%d", filepath.Base(device), index+1))
+	if _, err := os.Stat(ucsi); err == nil {
+		supplies = append(supplies, ucsi)
+	}
+	return supplies
+}
+
+// typecSelectedValue returns the selected value of a Type-C attribute, which
+// is either a single value or a list of options with the selected one in
+// brackets ("host [device]"). Missing attributes are returned as "".
+func typecSelectedValue(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	options := strings.Fields(string(data))
+	for _, o := range options {
+		if strings.HasPrefix(o, "[") {
+			return strings.Trim(o, "[]")
+		}
+	}
+	if len(options) == 1 {
+		return options[0]
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !notypec
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testTypecCollector struct {
+	tc Collector
+}
+
+func (c testTypecCollector) Collect(ch chan<- prometheus.Metric) {
+	c.tc.Update(ch)
+}
+
+func (c testTypecCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestTypecStats(t *testing.T) {
+	testcase := `# HELP node_typec_port_current_amps Current of the USB Power Delivery contract.
+	# TYPE node_typec_port_current_amps gauge
+	node_typec_port_current_amps{port="port0",power_supply="ucsi-source-psy-USBC000:001"} -1.5
+	node_typec_port_current_amps{port="port1",power_supply="port1-partner-psy"} 3.25
+	node_typec_port_current_amps{port="port1",power_supply="ucsi-source-psy-USBC000:002"} 0
+	# HELP node_typec_port_info Information about the USB Type-C port.
+	# TYPE node_typec_port_info gauge
+	node_typec_port_info{data_role="device",port="port0",preferred_role="sink",usb_power_delivery_revision="3.0"} 1
+	node_typec_port_info{data_role="device",port="port1",preferred_role="source",usb_power_delivery_revision="3.0"} 1
+	# HELP node_typec_port_power_role Current power role of the USB Type-C port.
+	# TYPE node_typec_port_power_role gauge
+	node_typec_port_power_role{port="port0",role="sink"} 1
+	node_typec_port_power_role{port="port0",role="source"} 0
+	node_typec_port_power_role{port="port1",role="sink"} 0
+	node_typec_port_power_role{port="port1",role="source"} 1
+	# HELP node_typec_port_voltage_volts Voltage of the USB Power Delivery contract.
+	# TYPE node_typec_port_voltage_volts gauge
+	node_typec_port_voltage_volts{port="port0",power_supply="ucsi-source-psy-USBC000:001"} 5
+	node_typec_port_voltage_volts{port="port1",power_supply="port1-partner-psy"} 20
+	node_typec_port_voltage_volts{port="port1",power_supply="ucsi-source-psy-USBC000:002"} 0
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewTypecCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testTypecCollector{tc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !novdso
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type vdsoCollector struct {
+	present *prometheus.Desc
+	size    *prometheus.Desc
+	logger  *slog.Logger
+}
+
+func init() {
+	registerCollector("vdso", defaultDisabled, NewVDSOCollector)
+}
+
+// NewVDSOCollector returns a new Collector exposing whether the vDSO is mapped.
+func NewVDSOCollector(logger *slog.Logger) (Collector, error) {
+	return &vdsoCollector{
+		present: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "vdso", "present"),
+			"Whether the vDSO is mapped into the node_exporter process.",
+			nil, nil,
+		),
+		size: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "vdso", "size_bytes"),
+			"Size of the vDSO mapping of the node_exporter process.",
+			nil, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *vdsoCollector) Update(ch chan<- prometheus.Metric) error {
+	// The vDSO is mapped into every process, so our own mappings are as good
+	// as those of PID 1 and readable without privileges.
+	file, err := os.Open(procFilePath("self/maps"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
+			c.logger.Debug("Can't read process mappings", "err", err)
+			return ErrNoData
+		}
+		return err
+	}
+	defer file.Close()
+
+	size, found, err := parseVDSOMapping(file)
+	if err != nil {
+		return fmt.Errorf("failed to parse vDSO mapping: %w", err)
+	}
+	if !found {
+		ch <- prometheus.MustNewConstMetric(c.present, prometheus.GaugeValue, 0)
+		return nil
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.present, prometheus.GaugeValue, 1)
+	ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, float64(size))
+	return nil
+}
+
+// parseVDSOMapping returns the size of the [vdso] mapping in a maps file,
+// with lines like "7ffd4b5f0000-7ffd4b5f2000 r-xp 00000000 00:00 0 [vdso]".
+func parseVDSOMapping(r io.Reader) (uint64, bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || fields[5] != "[vdso]" {
+			continue
+		}
+		start, end, found := strings.Cut(fields[0], "-")
+		if !found {
+			return 0, false, fmt.Errorf("invalid address range %q", fields[0])
+		}
+		s, err := strconv.ParseUint(start, 16, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		e, err := strconv.ParseUint(end, 16, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		return e - s, true, nil
+	}
+	return 0, false, scanner.Err()
+}
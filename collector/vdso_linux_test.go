@@ -0,0 +1,77 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !novdso
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testVDSOCollector struct {
+	vc Collector
+}
+
+func (c testVDSOCollector) Collect(ch chan<- prometheus.Metric) {
+	c.vc.Update(ch)
+}
+
+func (c testVDSOCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestVDSO(t *testing.T) {
+	testcase := `# HELP node_vdso_present Whether the vDSO is mapped into the node_exporter process.
+	# TYPE node_vdso_present gauge
+	node_vdso_present 1
+	# HELP node_vdso_size_bytes Size of the vDSO mapping of the node_exporter process.
+	# TYPE node_vdso_size_bytes gauge
+	node_vdso_size_bytes 8192
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewVDSOCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testVDSOCollector{vc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseVDSOMapping(t *testing.T) {
+	// Without a vDSO, e.g. with vdso=0 on the kernel command line.
+	maps := `00400000-00d8f000 r-xp 00000000 fd:01 1181824 /usr/bin/node_exporter
+7ffd4b5a1000-7ffd4b5c2000 rw-p 00000000 00:00 0 [stack]
+7ffd4b5ec000-7ffd4b5f0000 r--p 00000000 00:00 0 [vvar]
+`
+	if _, found, err := parseVDSOMapping(strings.NewReader(maps)); err != nil || found {
+		t.Errorf("want no vDSO mapping, got found=%t, err=%v", found, err)
+	}
+
+	if _, _, err := parseVDSOMapping(strings.NewReader("7ffd4b5f0000 r-xp 00000000 00:00 0 [vdso]\n")); err == nil {
+		t.Error("expected an error for an invalid address range")
+	}
+}
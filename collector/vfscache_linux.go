@@ -0,0 +1,111 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !novfscache
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// vfsCacheField is a value of a /proc/sys/fs file exposed as a metric.
+type vfsCacheField struct {
+	index int
+	desc  *prometheus.Desc
+}
+
+type vfsCacheCollector struct {
+	inodeFields  []vfsCacheField
+	dentryFields []vfsCacheField
+	logger       *slog.Logger
+}
+
+func init() {
+	registerCollector("vfscache", defaultDisabled, NewVFSCacheCollector)
+}
+
+// NewVFSCacheCollector returns a new Collector exposing the usage of the
+// inode and dentry caches.
+func NewVFSCacheCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "vfscache"
+	newDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, name),
+			help, nil, nil,
+		)
+	}
+
+	return &vfsCacheCollector{
+		// See Documentation/admin-guide/sysctl/fs.rst.
+		inodeFields: []vfsCacheField{
+			{0, newDesc("inodes", "Number of inodes allocated, from /proc/sys/fs/inode-nr.")},
+			{1, newDesc("inodes_free", "Number of free inodes, from /proc/sys/fs/inode-nr.")},
+		},
+		dentryFields: []vfsCacheField{
+			{0, newDesc("dentries", "Number of allocated dentries, from /proc/sys/fs/dentry-state.")},
+			{1, newDesc("dentries_unused", "Number of unused dentries, from /proc/sys/fs/dentry-state.")},
+			{4, newDesc("dentries_negative", "Number of negative dentries, from /proc/sys/fs/dentry-state. Always 0 before Linux 5.0.")},
+		},
+		logger: logger,
+	}, nil
+}
+
+func (c *vfsCacheCollector) Update(ch chan<- prometheus.Metric) error {
+	for _, f := range []struct {
+		file   string
+		fields []vfsCacheField
+	}{
+		{"sys/fs/inode-nr", c.inodeFields},
+		{"sys/fs/dentry-state", c.dentryFields},
+	} {
+		values, err := readVFSCacheValues(f.file)
+		if err != nil {
+			return err
+		}
+		for _, field := range f.fields {
+			// dentry-state always has six fields, before Linux 5.0
+			// nr_negative was an unused field reported as 0.
+			if field.index >= len(values) {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(field.desc, prometheus.GaugeValue, values[field.index])
+		}
+	}
+
+	return nil
+}
+
+func readVFSCacheValues(file string) ([]float64, error) {
+	data, err := os.ReadFile(procFilePath(file))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(data))
+	values := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value in %s: %w", file, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
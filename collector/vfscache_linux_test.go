@@ -0,0 +1,71 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !novfscache
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testVFSCacheCollector struct {
+	vc Collector
+}
+
+func (c testVFSCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.vc.Update(ch)
+}
+
+func (c testVFSCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestVFSCacheStats(t *testing.T) {
+	testcase := `# HELP node_vfscache_dentries Number of allocated dentries, from /proc/sys/fs/dentry-state.
+	# TYPE node_vfscache_dentries gauge
+	node_vfscache_dentries 123806
+	# HELP node_vfscache_dentries_negative Number of negative dentries, from /proc/sys/fs/dentry-state. Always 0 before Linux 5.0.
+	# TYPE node_vfscache_dentries_negative gauge
+	node_vfscache_dentries_negative 37291
+	# HELP node_vfscache_dentries_unused Number of unused dentries, from /proc/sys/fs/dentry-state.
+	# TYPE node_vfscache_dentries_unused gauge
+	node_vfscache_dentries_unused 103410
+	# HELP node_vfscache_inodes Number of inodes allocated, from /proc/sys/fs/inode-nr.
+	# TYPE node_vfscache_inodes gauge
+	node_vfscache_inodes 57434
+	# HELP node_vfscache_inodes_free Number of free inodes, from /proc/sys/fs/inode-nr.
+	# TYPE node_vfscache_inodes_free gauge
+	node_vfscache_inodes_free 21870
+	`
+	*procPath = "fixtures/proc"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewVFSCacheCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testVFSCacheCollector{vc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
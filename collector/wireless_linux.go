@@ -0,0 +1,169 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nowireless
+
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+type wirelessCollector struct {
+	fs            procfs.FS
+	linkQuality   *prometheus.Desc
+	signal        *prometheus.Desc
+	noise         *prometheus.Desc
+	invalidNwid   *prometheus.Desc
+	invalidCrypt  *prometheus.Desc
+	invalidFrag   *prometheus.Desc
+	excessRetries *prometheus.Desc
+	discardedMisc *prometheus.Desc
+	missedBeacons *prometheus.Desc
+	logger        *slog.Logger
+}
+
+func init() {
+	registerCollector("wireless", defaultDisabled, NewWirelessCollector)
+}
+
+// NewWirelessCollector returns a new Collector exposing wireless interface
+// statistics from /proc/net/wireless.
+func NewWirelessCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "wireless"
+
+	fs, err := procfs.NewFS(*procPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open procfs: %w", err)
+	}
+
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, name),
+			help,
+			[]string{"device"}, nil,
+		)
+	}
+
+	return &wirelessCollector{
+		fs:            fs,
+		linkQuality:   desc("link_quality", "Link quality of the wireless interface."),
+		signal:        desc("signal_dbm", "Signal level of the wireless interface in dBm."),
+		noise:         desc("noise_dbm", "Noise level of the wireless interface in dBm."),
+		invalidNwid:   desc("rx_invalid_nwid_total", "Number of received packets discarded because of a wrong network ID or ESSID."),
+		invalidCrypt:  desc("rx_invalid_crypt_total", "Number of received packets discarded because they couldn't be decrypted."),
+		invalidFrag:   desc("rx_invalid_frag_total", "Number of received packets discarded because the MAC reassembly failed."),
+		excessRetries: desc("tx_excessive_retries_total", "Number of transmitted packets discarded after reaching the maximum number of MAC retries."),
+		discardedMisc: desc("discarded_misc_total", "Number of packets discarded for other reasons."),
+		missedBeacons: desc("missed_beacons_total", "Number of missed beacons."),
+		logger:        logger,
+	}, nil
+}
+
+func (c *wirelessCollector) Update(ch chan<- prometheus.Metric) error {
+	interfaces, err := c.fs.Wireless()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("wireless statistics not found", "err", err)
+			return ErrNoData
+		}
+		return fmt.Errorf("couldn't get wireless statistics: %w", err)
+	}
+
+	// procfs drops the markers telling whether the level and noise values
+	// were updated, read them from the file directly.
+	updated, err := readWirelessUpdated(procFilePath("net/wireless"))
+	if err != nil {
+		return fmt.Errorf("couldn't get wireless statistics: %w", err)
+	}
+
+	for _, w := range interfaces {
+		for _, m := range []struct {
+			desc      *prometheus.Desc
+			valueType prometheus.ValueType
+			value     int
+		}{
+			{c.linkQuality, prometheus.GaugeValue, w.QualityLink},
+			{c.invalidNwid, prometheus.CounterValue, w.DiscardedNwid},
+			{c.invalidCrypt, prometheus.CounterValue, w.DiscardedCrypt},
+			{c.invalidFrag, prometheus.CounterValue, w.DiscardedFrag},
+			{c.excessRetries, prometheus.CounterValue, w.DiscardedRetry},
+			{c.discardedMisc, prometheus.CounterValue, w.DiscardedMisc},
+			{c.missedBeacons, prometheus.CounterValue, w.MissedBeacon},
+		} {
+			ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, float64(m.value), w.Name)
+		}
+
+		q := updated[w.Name]
+		if q.level && w.QualityLevel != wirelessQualityInvalid {
+			ch <- prometheus.MustNewConstMetric(c.signal, prometheus.GaugeValue, float64(w.QualityLevel), w.Name)
+		}
+		if q.noise && w.QualityNoise != wirelessQualityInvalid {
+			ch <- prometheus.MustNewConstMetric(c.noise, prometheus.GaugeValue, float64(w.QualityNoise), w.Name)
+		}
+	}
+
+	return nil
+}
+
+// wirelessQualityInvalid is how wext prints an invalid level or noise in dBm,
+// a value of 0 with the 256 offset of IW_QUAL_DBM subtracted.
+const wirelessQualityInvalid = -256
+
+// wirelessUpdated tells whether the level and noise of an interface were
+// updated, wext marks updated values with a trailing ".".
+type wirelessUpdated struct {
+	level bool
+	noise bool
+}
+
+// readWirelessUpdated reads the update markers of the level and noise values
+// from /proc/net/wireless by interface name.
+func readWirelessUpdated(path string) (map[string]wirelessUpdated, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	updated := make(map[string]wirelessUpdated)
+	scanner := bufio.NewScanner(file)
+	for n := 0; scanner.Scan(); n++ {
+		// Skip the 2 header lines.
+		if n < 2 {
+			continue
+		}
+		name, stats, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(stats)
+		if len(fields) < 4 {
+			continue
+		}
+		updated[strings.TrimSpace(name)] = wirelessUpdated{
+			level: strings.HasSuffix(fields[2], "."),
+			noise: strings.HasSuffix(fields[3], "."),
+		}
+	}
+
+	return updated, scanner.Err()
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nowireless
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testWirelessCollector struct {
+	wc Collector
+}
+
+func (c testWirelessCollector) Collect(ch chan<- prometheus.Metric) {
+	c.wc.Update(ch)
+}
+
+func (c testWirelessCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestWireless(t *testing.T) {
+	*procPath = "fixtures/proc"
+
+	// wlan1 reports the invalid noise value of wext.
+	testcase := `# HELP node_wireless_noise_dbm Noise level of the wireless interface in dBm.
+	# TYPE node_wireless_noise_dbm gauge
+	node_wireless_noise_dbm{device="wlan0"} -95
+	# HELP node_wireless_signal_dbm Signal level of the wireless interface in dBm.
+	# TYPE node_wireless_signal_dbm gauge
+	node_wireless_signal_dbm{device="wlan0"} -40
+	node_wireless_signal_dbm{device="wlan1"} -52
+	`
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewWirelessCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testWirelessCollector{wc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase),
+		"node_wireless_noise_dbm", "node_wireless_signal_dbm")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadWirelessUpdated(t *testing.T) {
+	const stats = `Inter-| sta-|   Quality        |   Discarded packets               | Missed | WE
+ face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon | 22
+ wlan0: 0000   70.  -40.  -95.        0      0      0      5      2        3      0
+ wlan1: 0000   60.  -52.  -256        0      0      0      1      0        0      0
+ wlan2: 0000    0   -60   -90         0      0      0      0      0        0      0
+`
+	path := filepath.Join(t.TempDir(), "wireless")
+	if err := os.WriteFile(path, []byte(stats), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readWirelessUpdated(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]wirelessUpdated{
+		"wlan0": {level: true, noise: true},
+		"wlan1": {level: true, noise: false},
+		"wlan2": {level: false, noise: false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noxattr
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+var xattrPaths = kingpin.Flag("collector.xattr.paths", "Path to expose extended attribute usage for, relative to --path.rootfs (repeatable).").Strings()
+
+type xattrCollector struct {
+	paths      []string
+	count      *prometheus.Desc
+	valueBytes *prometheus.Desc
+	listBytes  *prometheus.Desc
+	logger     *slog.Logger
+}
+
+func init() {
+	registerCollector("xattr", defaultDisabled, NewXattrCollector)
+}
+
+// NewXattrCollector returns a new Collector exposing the extended attributes
+// of the configured paths.
+func NewXattrCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "xattr"
+
+	labels := []string{"path", "namespace"}
+	return &xattrCollector{
+		paths: *xattrPaths,
+		count: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "count"),
+			"Number of extended attributes of the path.",
+			labels, nil,
+		),
+		valueBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "value_bytes"),
+			"Total size of the extended attribute values of the path.",
+			labels, nil,
+		),
+		listBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "list_bytes"),
+			"Size of the extended attribute name list of the path, limited to 65536 bytes by the kernel.",
+			[]string{"path"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *xattrCollector) Update(ch chan<- prometheus.Metric) error {
+	if len(c.paths) == 0 {
+		return ErrNoData
+	}
+
+	for _, path := range c.paths {
+		file := rootfsFilePath(path)
+		names, err := listXattrs(file)
+		if err != nil {
+			if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.ENOTSUP) {
+				c.logger.Debug("extended attributes not available", "path", path, "err", err)
+				continue
+			}
+			return fmt.Errorf("couldn't list extended attributes of %s: %w", path, err)
+		}
+
+		listSize := 0
+		counts := map[string]int{}
+		sizes := map[string]int{}
+		for _, name := range names {
+			listSize += len(name) + 1
+			// Names are prefixed with their namespace: user, system,
+			// security or trusted.
+			ns, _, _ := strings.Cut(name, ".")
+			counts[ns]++
+
+			// Symlinks are not followed, like for listing.
+			size, err := unix.Lgetxattr(file, name, nil)
+			if err != nil {
+				// The attribute can be removed after listing, and
+				// reading trusted attributes requires CAP_SYS_ADMIN.
+				c.logger.Debug("couldn't get extended attribute", "path", path, "name", name, "err", err)
+				continue
+			}
+			sizes[ns] += size
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.listBytes, prometheus.GaugeValue, float64(listSize), path)
+		for ns, n := range counts {
+			ch <- prometheus.MustNewConstMetric(c.count, prometheus.GaugeValue, float64(n), path, ns)
+			ch <- prometheus.MustNewConstMetric(c.valueBytes, prometheus.GaugeValue, float64(sizes[ns]), path, ns)
+		}
+	}
+
+	return nil
+}
+
+// listXattrs returns the names of the extended attributes of path, without
+// following symlinks.
+func listXattrs(path string) ([]string, error) {
+	for {
+		size, err := unix.Llistxattr(path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return nil, nil
+		}
+		buf := make([]byte, size)
+		n, err := unix.Llistxattr(path, buf)
+		if err != nil {
+			// Attributes were added since querying the size.
+			if errors.Is(err, unix.ERANGE) {
+				continue
+			}
+			return nil, err
+		}
+		// The list is a sequence of NUL-terminated names.
+		return strings.Split(strings.TrimSuffix(string(buf[:n]), "\x00"), "\x00"), nil
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noxattr
+
+package collector
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/sys/unix"
+)
+
+type testXattrCollector struct {
+	xc Collector
+}
+
+func (c testXattrCollector) Collect(ch chan<- prometheus.Metric) {
+	c.xc.Update(ch)
+}
+
+func (c testXattrCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestXattrStats(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "data")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for name, value := range map[string]string{
+		"user.checksum": "sha256:2c26b46b",
+		"user.origin":   "backup",
+	} {
+		if err := unix.Setxattr(file, name, []byte(value), 0); err != nil {
+			if errors.Is(err, unix.ENOTSUP) {
+				t.Skip("user extended attributes not supported on", root)
+			}
+			t.Fatal(err)
+		}
+	}
+
+	testcase := `# HELP node_xattr_count Number of extended attributes of the path.
+	# TYPE node_xattr_count gauge
+	node_xattr_count{namespace="user",path="/data"} 2
+	# HELP node_xattr_list_bytes Size of the extended attribute name list of the path, limited to 65536 bytes by the kernel.
+	# TYPE node_xattr_list_bytes gauge
+	node_xattr_list_bytes{path="/data"} 26
+	# HELP node_xattr_value_bytes Total size of the extended attribute values of the path.
+	# TYPE node_xattr_value_bytes gauge
+	node_xattr_value_bytes{namespace="user",path="/data"} 21
+	`
+	defer func(path string) { *rootfsPath = path }(*rootfsPath)
+	*rootfsPath = root
+	*xattrPaths = []string{"/data", "/missing"}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewXattrCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testXattrCollector{xc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noxdp
+
+package collector
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/mdlayher/netlink"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sys/unix"
+)
+
+// Constants from include/uapi/linux/if_link.h.
+const (
+	xdpAttachedNone  = 0
+	xdpAttachedDrv   = 1
+	xdpAttachedSKB   = 2
+	xdpAttachedHW    = 3
+	xdpAttachedMulti = 4
+)
+
+// xdpAttachModes maps the XDP_ATTACHED_* values to the mode names used by
+// `ip link`.
+var xdpAttachModes = map[uint8]string{
+	xdpAttachedDrv: "native",
+	xdpAttachedSKB: "generic",
+	xdpAttachedHW:  "offload",
+}
+
+// xdpModeProgIDs maps the per mode program ID attributes nested in IFLA_XDP
+// to their mode names.
+var xdpModeProgIDs = map[uint16]string{
+	unix.IFLA_XDP_DRV_PROG_ID: "native",
+	unix.IFLA_XDP_SKB_PROG_ID: "generic",
+	unix.IFLA_XDP_HW_PROG_ID:  "offload",
+}
+
+// xdpProgram is an XDP program attached to a network interface.
+type xdpProgram struct {
+	mode string
+	id   uint32
+}
+
+type xdpCollector struct {
+	attached *prometheus.Desc
+	info     *prometheus.Desc
+	logger   *slog.Logger
+}
+
+func init() {
+	registerCollector("xdp", defaultDisabled, NewXDPCollector)
+}
+
+// NewXDPCollector returns a new Collector exposing XDP programs attached to
+// network interfaces.
+func NewXDPCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "xdp"
+
+	return &xdpCollector{
+		attached: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "program_attached"),
+			"Whether an XDP program is attached to the network interface.",
+			[]string{"device"}, nil,
+		),
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "program_info"),
+			"XDP program attached to the network interface. Mode is one of generic, native or offload.",
+			[]string{"device", "mode", "prog_id", "prog_name"}, nil,
+		),
+		logger: logger,
+	}, nil
+}
+
+func (c *xdpCollector) Update(ch chan<- prometheus.Metric) error {
+	// rtnetlink's LinkXDP drops the per mode program IDs, so the links are
+	// dumped and decoded here.
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return fmt.Errorf("couldn't connect rtnetlink: %w", err)
+	}
+	defer conn.Close()
+
+	msgs, err := conn.Execute(netlink.Message{
+		Header: netlink.Header{Type: unix.RTM_GETLINK, Flags: netlink.Request | netlink.Dump},
+		Data:   make([]byte, unix.SizeofIfInfomsg),
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get links: %w", err)
+	}
+
+	for _, m := range msgs {
+		if len(m.Data) < unix.SizeofIfInfomsg {
+			return fmt.Errorf("short link message of %d bytes", len(m.Data))
+		}
+		device, progs, err := parseXDPLink(m.Data[unix.SizeofIfInfomsg:])
+		if err != nil {
+			return fmt.Errorf("couldn't parse link: %w", err)
+		}
+		if device == "" {
+			continue
+		}
+		if len(progs) == 0 {
+			ch <- prometheus.MustNewConstMetric(c.attached, prometheus.GaugeValue, 0, device)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.attached, prometheus.GaugeValue, 1, device)
+
+		for _, prog := range progs {
+			name, err := bpfProgName(prog.id)
+			if err != nil {
+				// Looking up programs requires CAP_SYS_ADMIN.
+				c.logger.Debug("couldn't get XDP program name", "device", device, "prog_id", prog.id, "err", err)
+			}
+			ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1,
+				device, prog.mode, strconv.FormatUint(uint64(prog.id), 10), name)
+		}
+	}
+
+	return nil
+}
+
+// parseXDPLink parses the attributes of an RTM_NEWLINK message, returning
+// the interface name and the XDP programs attached to it. With programs in
+// more than one mode, the kernel reports XDP_ATTACHED_MULTI without
+// IFLA_XDP_PROG_ID, the programs are only listed in the per mode attributes.
+func parseXDPLink(b []byte) (string, []xdpProgram, error) {
+	ad, err := netlink.NewAttributeDecoder(b)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		name     string
+		attached uint8
+		progID   uint32
+		progs    []xdpProgram
+	)
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.IFLA_IFNAME:
+			name = ad.String()
+		case unix.IFLA_XDP:
+			ad.Nested(func(xad *netlink.AttributeDecoder) error {
+				for xad.Next() {
+					switch t := xad.Type(); t {
+					case unix.IFLA_XDP_ATTACHED:
+						attached = xad.Uint8()
+					case unix.IFLA_XDP_PROG_ID:
+						progID = xad.Uint32()
+					case unix.IFLA_XDP_DRV_PROG_ID, unix.IFLA_XDP_SKB_PROG_ID, unix.IFLA_XDP_HW_PROG_ID:
+						progs = append(progs, xdpProgram{mode: xdpModeProgIDs[t], id: xad.Uint32()})
+					}
+				}
+				return nil
+			})
+		}
+	}
+	if err := ad.Err(); err != nil {
+		return "", nil, err
+	}
+
+	// Kernels before 4.19 only report the program of the single attach mode.
+	if len(progs) == 0 && attached != xdpAttachedNone && attached != xdpAttachedMulti {
+		mode, ok := xdpAttachModes[attached]
+		if !ok {
+			mode = strconv.Itoa(int(attached))
+		}
+		progs = append(progs, xdpProgram{mode: mode, id: progID})
+	}
+	slices.SortFunc(progs, func(a, b xdpProgram) int { return strings.Compare(a.mode, b.mode) })
+
+	return name, progs, nil
+}
+
+// bpfAttrGetID is the leading part of union bpf_attr used by the
+// BPF_*_GET_FD_BY_ID commands.
+type bpfAttrGetID struct {
+	ID        uint32
+	NextID    uint32
+	OpenFlags uint32
+}
+
+// bpfAttrGetInfo is the leading part of union bpf_attr used by
+// BPF_OBJ_GET_INFO_BY_FD.
+type bpfAttrGetInfo struct {
+	BPFFD   uint32
+	InfoLen uint32
+	Info    uint64
+}
+
+// bpfProgInfo is the leading part of struct bpf_prog_info from
+// include/uapi/linux/bpf.h, up to and including the program name.
+type bpfProgInfo struct {
+	Type            uint32
+	ID              uint32
+	Tag             [8]byte
+	JitedProgLen    uint32
+	XlatedProgLen   uint32
+	JitedProgInsns  uint64
+	XlatedProgInsns uint64
+	LoadTime        uint64
+	CreatedByUID    uint32
+	NrMapIDs        uint32
+	MapIDs          uint64
+	Name            [16]byte
+}
+
+// bpfProgName looks up the name of a BPF program by its ID with
+// BPF_PROG_GET_FD_BY_ID and BPF_OBJ_GET_INFO_BY_FD.
+func bpfProgName(id uint32) (string, error) {
+	if id == 0 {
+		return "", nil
+	}
+
+	getFD := bpfAttrGetID{ID: id}
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_GET_FD_BY_ID, uintptr(unsafe.Pointer(&getFD)), unsafe.Sizeof(getFD))
+	if errno != 0 {
+		return "", fmt.Errorf("BPF_PROG_GET_FD_BY_ID failed: %w", errno)
+	}
+	defer unix.Close(int(fd))
+
+	// Only the leading part of struct bpf_prog_info is requested, the kernel
+	// fills in at most InfoLen bytes.
+	var info bpfProgInfo
+	getInfo := bpfAttrGetInfo{
+		BPFFD:   uint32(fd),
+		InfoLen: uint32(unsafe.Sizeof(info)),
+		Info:    uint64(uintptr(unsafe.Pointer(&info))),
+	}
+	_, _, errno = unix.Syscall(unix.SYS_BPF, unix.BPF_OBJ_GET_INFO_BY_FD, uintptr(unsafe.Pointer(&getInfo)), unsafe.Sizeof(getInfo))
+	if errno != 0 {
+		return "", fmt.Errorf("BPF_OBJ_GET_INFO_BY_FD failed: %w", errno)
+	}
+
+	return bpfObjName(info.Name), nil
+}
+
+// bpfObjName converts a NUL-padded BPF object name to a string.
+func bpfObjName(name [16]byte) string {
+	b := name[:]
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noxdp
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func TestBPFStructLayout(t *testing.T) {
+	// Offsets from include/uapi/linux/bpf.h.
+	for name, tc := range map[string]struct {
+		got, want uintptr
+	}{
+		"bpf_attr.prog_id":        {unsafe.Offsetof(bpfAttrGetID{}.ID), 0},
+		"bpf_attr.next_id":        {unsafe.Offsetof(bpfAttrGetID{}.NextID), 4},
+		"bpf_attr.open_flags":     {unsafe.Offsetof(bpfAttrGetID{}.OpenFlags), 8},
+		"bpf_attr.info.bpf_fd":    {unsafe.Offsetof(bpfAttrGetInfo{}.BPFFD), 0},
+		"bpf_attr.info.info_len":  {unsafe.Offsetof(bpfAttrGetInfo{}.InfoLen), 4},
+		"bpf_attr.info.info":      {unsafe.Offsetof(bpfAttrGetInfo{}.Info), 8},
+		"bpf_prog_info.id":        {unsafe.Offsetof(bpfProgInfo{}.ID), 4},
+		"bpf_prog_info.tag":       {unsafe.Offsetof(bpfProgInfo{}.Tag), 8},
+		"bpf_prog_info.load_time": {unsafe.Offsetof(bpfProgInfo{}.LoadTime), 40},
+		"bpf_prog_info.map_ids":   {unsafe.Offsetof(bpfProgInfo{}.MapIDs), 56},
+		"bpf_prog_info.name":      {unsafe.Offsetof(bpfProgInfo{}.Name), 64},
+		"sizeof(bpf_prog_info)":   {unsafe.Sizeof(bpfProgInfo{}), 80},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s: want offset %d, got %d", name, tc.want, tc.got)
+		}
+	}
+}
+
+func TestBPFObjName(t *testing.T) {
+	for want, in := range map[string][16]byte{
+		"":                 {},
+		"xdp_prog":         {'x', 'd', 'p', '_', 'p', 'r', 'o', 'g'},
+		"xdp_dispatcher12": {'x', 'd', 'p', '_', 'd', 'i', 's', 'p', 'a', 't', 'c', 'h', 'e', 'r', '1', '2'},
+	} {
+		if got := bpfObjName(in); got != want {
+			t.Errorf("want name %q, got %q", want, got)
+		}
+	}
+}
+
+func TestBPFProgNameZeroID(t *testing.T) {
+	name, err := bpfProgName(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "" {
+		t.Errorf("want empty name for program ID 0, got %q", name)
+	}
+}
+
+func TestParseXDPLink(t *testing.T) {
+	for name, tc := range map[string]struct {
+		xdp   func(*netlink.AttributeEncoder) error
+		progs []xdpProgram
+	}{
+		"none": {
+			xdp: func(ae *netlink.AttributeEncoder) error {
+				ae.Uint8(unix.IFLA_XDP_ATTACHED, xdpAttachedNone)
+				return nil
+			},
+		},
+		"native": {
+			xdp: func(ae *netlink.AttributeEncoder) error {
+				ae.Uint8(unix.IFLA_XDP_ATTACHED, xdpAttachedDrv)
+				ae.Uint32(unix.IFLA_XDP_PROG_ID, 42)
+				ae.Uint32(unix.IFLA_XDP_DRV_PROG_ID, 42)
+				return nil
+			},
+			progs: []xdpProgram{{mode: "native", id: 42}},
+		},
+		"multi": {
+			// rtnl_xdp_fill leaves out IFLA_XDP_PROG_ID for multiple modes.
+			xdp: func(ae *netlink.AttributeEncoder) error {
+				ae.Uint8(unix.IFLA_XDP_ATTACHED, xdpAttachedMulti)
+				ae.Uint32(unix.IFLA_XDP_SKB_PROG_ID, 7)
+				ae.Uint32(unix.IFLA_XDP_DRV_PROG_ID, 42)
+				ae.Uint32(unix.IFLA_XDP_HW_PROG_ID, 99)
+				return nil
+			},
+			progs: []xdpProgram{{mode: "generic", id: 7}, {mode: "native", id: 42}, {mode: "offload", id: 99}},
+		},
+		"without per mode IDs": {
+			xdp: func(ae *netlink.AttributeEncoder) error {
+				ae.Uint8(unix.IFLA_XDP_ATTACHED, xdpAttachedSKB)
+				ae.Uint32(unix.IFLA_XDP_PROG_ID, 7)
+				return nil
+			},
+			progs: []xdpProgram{{mode: "generic", id: 7}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ae := netlink.NewAttributeEncoder()
+			ae.String(unix.IFLA_IFNAME, "eth0")
+			ae.Nested(unix.IFLA_XDP, tc.xdp)
+			b, err := ae.Encode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			device, progs, err := parseXDPLink(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if device != "eth0" {
+				t.Errorf("want device eth0, got %q", device)
+			}
+			if !reflect.DeepEqual(progs, tc.progs) {
+				t.Errorf("want programs %+v, got %+v", tc.progs, progs)
+			}
+		})
+	}
+}
@@ -19,13 +19,26 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/mdlayher/netlink"
+	"github.com/mdlayher/netlink/nlenc"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/procfs"
 )
 
+// xfrmEntries holds the number of IPsec security associations and policies.
+type xfrmEntries struct {
+	sa       uint32
+	policies map[string]uint32
+}
+
+var xfrmEntriesEnabled = kingpin.Flag("collector.xfrm.entries", "Expose the number of IPsec SAs and policies, queried over netlink.").Default("false").Bool()
+
 type xfrmCollector struct {
 	fs     procfs.FS
 	logger *slog.Logger
+	// readEntries is nil unless --collector.xfrm.entries is set.
+	readEntries func() (*xfrmEntries, error)
 }
 
 func init() {
@@ -39,10 +52,14 @@ func NewXfrmCollector(logger *slog.Logger) (Collector, error) {
 		return nil, fmt.Errorf("failed to open procfs: %w", err)
 	}
 
-	return &xfrmCollector{
+	c := &xfrmCollector{
 		fs:     fs,
 		logger: logger,
-	}, nil
+	}
+	if *xfrmEntriesEnabled {
+		c.readEntries = readXfrmEntries
+	}
+	return c, nil
 }
 
 var (
@@ -186,6 +203,16 @@ var (
 		"State hasn’t been fully acquired before use",
 		nil, nil,
 	)
+	xfrmSAEntriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "xfrm", "sa_entries"),
+		"Number of IPsec security associations in the SAD",
+		nil, nil,
+	)
+	xfrmPolicyEntriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "xfrm", "policy_entries"),
+		"Number of IPsec policies in the SPD by direction",
+		[]string{"direction"}, nil,
+	)
 )
 
 func (c *xfrmCollector) Update(ch chan<- prometheus.Metric) error {
@@ -223,5 +250,102 @@ func (c *xfrmCollector) Update(ch chan<- prometheus.Metric) error {
 	ch <- prometheus.MustNewConstMetric(xfrmOutStateInvalidDesc, prometheus.CounterValue, float64(stat.XfrmOutStateInvalid))
 	ch <- prometheus.MustNewConstMetric(xfrmAcquireErrorDesc, prometheus.CounterValue, float64(stat.XfrmAcquireError))
 
-	return err
+	if c.readEntries == nil {
+		return nil
+	}
+
+	// Querying the SAD and SPD requires CAP_NET_ADMIN, so don't fail the
+	// whole collector when it isn't available.
+	entries, err := c.readEntries()
+	if err != nil {
+		c.logger.Debug("failed to read xfrm SAD and SPD info", "err", err)
+		return nil
+	}
+	ch <- prometheus.MustNewConstMetric(xfrmSAEntriesDesc, prometheus.GaugeValue, float64(entries.sa))
+	for direction, count := range entries.policies {
+		ch <- prometheus.MustNewConstMetric(xfrmPolicyEntriesDesc, prometheus.GaugeValue, float64(count), direction)
+	}
+
+	return nil
+}
+
+// Constants from include/uapi/linux/xfrm.h.
+const (
+	netlinkXfrm = 6
+
+	xfrmMsgGetSADInfo = 0x23
+	xfrmMsgGetSPDInfo = 0x25
+
+	xfrmaSADCount = 1
+	xfrmaSPDInfo  = 1
+)
+
+func readXfrmEntries() (*xfrmEntries, error) {
+	conn, err := netlink.Dial(netlinkXfrm, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries := &xfrmEntries{}
+	if err := xfrmQuery(conn, xfrmMsgGetSADInfo, func(ad *netlink.AttributeDecoder) {
+		if ad.Type() == xfrmaSADCount {
+			entries.sa = ad.Uint32()
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("failed to query SAD info: %w", err)
+	}
+
+	if err := xfrmQuery(conn, xfrmMsgGetSPDInfo, func(ad *netlink.AttributeDecoder) {
+		if ad.Type() == xfrmaSPDInfo {
+			entries.policies = parseXfrmSPDInfo(ad.Bytes())
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("failed to query SPD info: %w", err)
+	}
+
+	return entries, nil
+}
+
+// xfrmQuery sends a GET{SAD,SPD}INFO request and calls fn for every
+// attribute of the reply. Both request and reply carry a u32 flags field
+// before the attributes.
+func xfrmQuery(conn *netlink.Conn, msgType netlink.HeaderType, fn func(*netlink.AttributeDecoder)) error {
+	msgs, err := conn.Execute(netlink.Message{
+		Header: netlink.Header{Type: msgType, Flags: netlink.Request},
+		Data:   []byte{0xff, 0xff, 0xff, 0xff},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if len(m.Data) < 4 {
+			return fmt.Errorf("short xfrm message of %d bytes", len(m.Data))
+		}
+		ad, err := netlink.NewAttributeDecoder(m.Data[4:])
+		if err != nil {
+			return err
+		}
+		for ad.Next() {
+			fn(ad)
+		}
+		if err := ad.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseXfrmSPDInfo parses struct xfrmu_spdinfo, which starts with the
+// policy counts for the in, out and fwd directions.
+func parseXfrmSPDInfo(b []byte) map[string]uint32 {
+	policies := make(map[string]uint32)
+	for i, direction := range []string{"in", "out", "fwd"} {
+		if len(b) < (i+1)*4 {
+			break
+		}
+		policies[direction] = nlenc.Uint32(b[i*4 : (i+1)*4])
+	}
+	return policies
 }
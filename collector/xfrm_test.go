@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/mdlayher/netlink/nlenc"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 )
@@ -123,6 +125,14 @@ func TestXfrmStats(t *testing.T) {
 	# HELP node_xfrm_out_state_seq_error_packets_total Sequence error i.e. Sequence number overflow
 	# TYPE node_xfrm_out_state_seq_error_packets_total counter
 	node_xfrm_out_state_seq_error_packets_total 543
+	# HELP node_xfrm_policy_entries Number of IPsec policies in the SPD by direction
+	# TYPE node_xfrm_policy_entries gauge
+	node_xfrm_policy_entries{direction="fwd"} 2
+	node_xfrm_policy_entries{direction="in"} 4
+	node_xfrm_policy_entries{direction="out"} 4
+	# HELP node_xfrm_sa_entries Number of IPsec security associations in the SAD
+	# TYPE node_xfrm_sa_entries gauge
+	node_xfrm_sa_entries 8
 	`
 	*procPath = "fixtures/proc"
 
@@ -131,6 +141,11 @@ func TestXfrmStats(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	c.(*xfrmCollector).readEntries = func() (*xfrmEntries, error) {
+		return &xfrmEntries{sa: 8, policies: parseXfrmSPDInfo(slices.Concat(
+			nlenc.Uint32Bytes(4), nlenc.Uint32Bytes(4), nlenc.Uint32Bytes(2), nlenc.Uint32Bytes(1),
+		))}, nil
+	}
 	reg := prometheus.NewRegistry()
 	reg.MustRegister(&testXfrmCollector{xc: c})
 
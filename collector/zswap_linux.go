@@ -0,0 +1,106 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nozswap
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type zswapCollector struct {
+	enabled  *prometheus.Desc
+	counters []zswapCounter
+	logger   *slog.Logger
+}
+
+// zswapCounter is a counter of /sys/kernel/debug/zswap.
+type zswapCounter struct {
+	file string
+	desc *prometheus.Desc
+}
+
+func init() {
+	registerCollector("zswap", defaultDisabled, NewZswapCollector)
+}
+
+// NewZswapCollector returns a new Collector exposing whether zswap is enabled
+// and its rejected stores.
+func NewZswapCollector(logger *slog.Logger) (Collector, error) {
+	const subsystem = "zswap"
+
+	counter := func(file, help string) zswapCounter {
+		return zswapCounter{file, prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, file+"_total"),
+			help, nil, nil,
+		)}
+	}
+
+	return &zswapCollector{
+		enabled: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "enabled"),
+			"Whether zswap is enabled (1) or not (0).",
+			nil, nil,
+		),
+		counters: []zswapCounter{
+			counter("pool_limit_hit", "Number of times the pool limit was reached."),
+			counter("reject_alloc_fail", "Number of stores rejected because memory could not be allocated."),
+			counter("reject_compress_fail", "Number of stores rejected because the compression failed."),
+			counter("reject_compress_poor", "Number of stores rejected because the page compressed poorly."),
+			counter("reject_kmemcache_fail", "Number of stores rejected because no entry could be allocated."),
+			counter("reject_reclaim_fail", "Number of stores rejected because reclaiming from a full pool failed."),
+		},
+		logger: logger,
+	}, nil
+}
+
+func (c *zswapCollector) Update(ch chan<- prometheus.Metric) error {
+	enabled, err := os.ReadFile(sysFilePath("module/zswap/parameters/enabled"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			c.logger.Debug("zswap not available", "err", err)
+			return ErrNoData
+		}
+		return err
+	}
+	isEnabled := 0.0
+	if strings.TrimSpace(string(enabled)) == "Y" {
+		isEnabled = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.enabled, prometheus.GaugeValue, isEnabled)
+
+	// The counters are only available with debugfs mounted and readable,
+	// which usually requires root. reject_compress_fail exists since
+	// Linux 6.5.
+	dir := sysFilePath("kernel/debug/zswap")
+	for _, counter := range c.counters {
+		value, err := readUintFromFile(filepath.Join(dir, counter.file))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) || errors.Is(err, os.ErrPermission) {
+				c.logger.Debug("zswap counter not available", "file", counter.file, "err", err)
+				continue
+			}
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(counter.desc, prometheus.CounterValue, float64(value))
+	}
+
+	return nil
+}
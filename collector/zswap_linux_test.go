@@ -0,0 +1,77 @@
+// Copyright 2026 The Prometheus Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nozswap
+
+package collector
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testZswapCollector struct {
+	tc Collector
+}
+
+func (c testZswapCollector) Collect(ch chan<- prometheus.Metric) {
+	c.tc.Update(ch)
+}
+
+func (c testZswapCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func TestZswapStats(t *testing.T) {
+	testcase := `# HELP node_zswap_enabled Whether zswap is enabled (1) or not (0).
+	# TYPE node_zswap_enabled gauge
+	node_zswap_enabled 1
+	# HELP node_zswap_pool_limit_hit_total Number of times the pool limit was reached.
+	# TYPE node_zswap_pool_limit_hit_total counter
+	node_zswap_pool_limit_hit_total 12
+	# HELP node_zswap_reject_alloc_fail_total Number of stores rejected because memory could not be allocated.
+	# TYPE node_zswap_reject_alloc_fail_total counter
+	node_zswap_reject_alloc_fail_total 3
+	# HELP node_zswap_reject_compress_fail_total Number of stores rejected because the compression failed.
+	# TYPE node_zswap_reject_compress_fail_total counter
+	node_zswap_reject_compress_fail_total 1
+	# HELP node_zswap_reject_compress_poor_total Number of stores rejected because the page compressed poorly.
+	# TYPE node_zswap_reject_compress_poor_total counter
+	node_zswap_reject_compress_poor_total 120
+	# HELP node_zswap_reject_kmemcache_fail_total Number of stores rejected because no entry could be allocated.
+	# TYPE node_zswap_reject_kmemcache_fail_total counter
+	node_zswap_reject_kmemcache_fail_total 0
+	# HELP node_zswap_reject_reclaim_fail_total Number of stores rejected because reclaiming from a full pool failed.
+	# TYPE node_zswap_reject_reclaim_fail_total counter
+	node_zswap_reject_reclaim_fail_total 7
+	`
+	*sysPath = "fixtures/sys"
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c, err := NewZswapCollector(logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&testZswapCollector{tc: c})
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(testcase))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -38,6 +38,7 @@ enabled_collectors=$(cat << COLLECTORS
   arp
   bcache
   bcachefs
+  bluetooth
   bonding
   btrfs
   buddyinfo
@@ -45,7 +46,9 @@ enabled_collectors=$(cat << COLLECTORS
   conntrack
   cpu
   cpufreq
+  cpu_cache
   cpu_vulnerabilities
+  dccp
   diskstats
   dmi
   drbd
@@ -56,11 +59,15 @@ enabled_collectors=$(cat << COLLECTORS
   hwmon
   infiniband
   interrupts
+  iommu
+  ipforward
   ipvs
   kernel_hung
+  khugepaged
   ksmd
   lnstat
   loadavg
+  lsm
   mdadm
   meminfo
   meminfo_numa
@@ -86,6 +93,7 @@ enabled_collectors=$(cat << COLLECTORS
   vmstat
   watchdog
   wifi
+  wireless
   xfrm
   xfs
   zfs
@@ -170,6 +178,7 @@ collector_flags=$(cat << FLAGS
   --collector.cpu.info.bugs-include=${cpu_info_bugs}
   --collector.cpu.info.flags-include=${cpu_info_flags}
   --collector.hwmon.chip-include=(applesmc|coretemp|hwmon4|nct6779)
+  --collector.ipforward.per-device
   --collector.netclass.ignore-invalid-speed
   --collector.netclass.ignored-devices=(dmz|int)
   --collector.netdev.device-include=lo